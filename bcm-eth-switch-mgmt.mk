@@ -15,9 +15,23 @@ define BCM_ETH_SWITCH_MGMT_POST_RSYNC_HOOK
 	mkdir -p $(@D)/_gopath/{bin,pkg,src}
 	mkdir -p $(@D)/_gopath/src/OpenNosPluginForMstpd/gRPCServices
 	mkdir -p $(@D)/_gopath/src/OpenNosTeamdPlugin/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchBpdu/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchFdb/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchPortSecurity/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchVlan/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchPort/gRPCServices
+	mkdir -p $(@D)/_gopath/src/OpenNosSwitchMirror/gRPCServices
 	GOPATH=$(@D)/_gopath ${GO_BIN} get -u google.golang.org/grpc
+	GOPATH=$(@D)/_gopath ${GO_BIN} get -u github.com/vishvananda/netlink
+	GOPATH=$(@D)/_gopath ${GO_BIN} get -u github.com/prometheus/client_golang/prometheus
 	cp -r $(@D)/gRPCServices/stp_management* $(@D)/_gopath/src/OpenNosPluginForMstpd/gRPCServices
 	cp -r $(@D)/gRPCServices/lag_management* $(@D)/_gopath/src/OpenNosTeamdPlugin/gRPCServices
+	cp -r $(@D)/gRPCServices/bpdu_protection* $(@D)/_gopath/src/OpenNosSwitchBpdu/gRPCServices
+	cp -r $(@D)/gRPCServices/fdb_management* $(@D)/_gopath/src/OpenNosSwitchFdb/gRPCServices
+	cp -r $(@D)/gRPCServices/port_security* $(@D)/_gopath/src/OpenNosSwitchPortSecurity/gRPCServices
+	cp -r $(@D)/gRPCServices/vlan_management* $(@D)/_gopath/src/OpenNosSwitchVlan/gRPCServices
+	cp -r $(@D)/gRPCServices/port_management* $(@D)/_gopath/src/OpenNosSwitchPort/gRPCServices
+	cp -r $(@D)/gRPCServices/mirror_management* $(@D)/_gopath/src/OpenNosSwitchMirror/gRPCServices
 	cp -rf ${GO_OPENNSL_DIR}/_gopath/src/* $(@D)/_gopath/src
 	cp -rf ${GO_OPENNSL_DIR}/_gopath/pkg/* $(@D)/_gopath/pkg
 	mkdir -p $(@D)/_gopath/src/bcm-eth-switch-mgmt
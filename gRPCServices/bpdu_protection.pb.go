@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: bpdu_protection.proto
+
+package OpenNos_Switch_Bpdu
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BpduResult_Result int32
+
+const (
+	BpduResult_FAILED  BpduResult_Result = 0
+	BpduResult_SUCCESS BpduResult_Result = 1
+)
+
+var BpduResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var BpduResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x BpduResult_Result) String() string {
+	return proto.EnumName(BpduResult_Result_name, int32(x))
+}
+
+func (BpduResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{4, 0}
+}
+
+type BpduIface struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BpduIface) Reset()         { *m = BpduIface{} }
+func (m *BpduIface) String() string { return proto.CompactTextString(m) }
+func (*BpduIface) ProtoMessage()    {}
+func (*BpduIface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{0}
+}
+
+func (m *BpduIface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BpduIface.Unmarshal(m, b)
+}
+func (m *BpduIface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BpduIface.Marshal(b, m, deterministic)
+}
+func (m *BpduIface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BpduIface.Merge(m, src)
+}
+func (m *BpduIface) XXX_Size() int {
+	return xxx_messageInfo_BpduIface.Size(m)
+}
+func (m *BpduIface) XXX_DiscardUnknown() {
+	xxx_messageInfo_BpduIface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BpduIface proto.InternalMessageInfo
+
+func (m *BpduIface) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+type BpduProtectionConfig struct {
+	Interface *BpduIface `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Guard     bool       `protobuf:"varint,2,opt,name=guard,proto3" json:"guard,omitempty"`
+	Filter    bool       `protobuf:"varint,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Period after which error-disabled port is enabled again. Zero disables auto-recovery.
+	AutoRecoverySec      uint32   `protobuf:"varint,4,opt,name=autoRecoverySec,proto3" json:"autoRecoverySec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BpduProtectionConfig) Reset()         { *m = BpduProtectionConfig{} }
+func (m *BpduProtectionConfig) String() string { return proto.CompactTextString(m) }
+func (*BpduProtectionConfig) ProtoMessage()    {}
+func (*BpduProtectionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{1}
+}
+
+func (m *BpduProtectionConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BpduProtectionConfig.Unmarshal(m, b)
+}
+func (m *BpduProtectionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BpduProtectionConfig.Marshal(b, m, deterministic)
+}
+func (m *BpduProtectionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BpduProtectionConfig.Merge(m, src)
+}
+func (m *BpduProtectionConfig) XXX_Size() int {
+	return xxx_messageInfo_BpduProtectionConfig.Size(m)
+}
+func (m *BpduProtectionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BpduProtectionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BpduProtectionConfig proto.InternalMessageInfo
+
+func (m *BpduProtectionConfig) GetInterface() *BpduIface {
+	if m != nil {
+		return m.Interface
+	}
+	return nil
+}
+
+func (m *BpduProtectionConfig) GetGuard() bool {
+	if m != nil {
+		return m.Guard
+	}
+	return false
+}
+
+func (m *BpduProtectionConfig) GetFilter() bool {
+	if m != nil {
+		return m.Filter
+	}
+	return false
+}
+
+func (m *BpduProtectionConfig) GetAutoRecoverySec() uint32 {
+	if m != nil {
+		return m.AutoRecoverySec
+	}
+	return 0
+}
+
+type BpduProtectionStatus struct {
+	Config               *BpduProtectionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	ErrDisabled          bool                  `protobuf:"varint,2,opt,name=errDisabled,proto3" json:"errDisabled,omitempty"`
+	GuardViolations      uint64                `protobuf:"varint,3,opt,name=guardViolations,proto3" json:"guardViolations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BpduProtectionStatus) Reset()         { *m = BpduProtectionStatus{} }
+func (m *BpduProtectionStatus) String() string { return proto.CompactTextString(m) }
+func (*BpduProtectionStatus) ProtoMessage()    {}
+func (*BpduProtectionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{2}
+}
+
+func (m *BpduProtectionStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BpduProtectionStatus.Unmarshal(m, b)
+}
+func (m *BpduProtectionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BpduProtectionStatus.Marshal(b, m, deterministic)
+}
+func (m *BpduProtectionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BpduProtectionStatus.Merge(m, src)
+}
+func (m *BpduProtectionStatus) XXX_Size() int {
+	return xxx_messageInfo_BpduProtectionStatus.Size(m)
+}
+func (m *BpduProtectionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BpduProtectionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BpduProtectionStatus proto.InternalMessageInfo
+
+func (m *BpduProtectionStatus) GetConfig() *BpduProtectionConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *BpduProtectionStatus) GetErrDisabled() bool {
+	if m != nil {
+		return m.ErrDisabled
+	}
+	return false
+}
+
+func (m *BpduProtectionStatus) GetGuardViolations() uint64 {
+	if m != nil {
+		return m.GuardViolations
+	}
+	return 0
+}
+
+type ErrDisableEvent struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrDisabled          bool     `protobuf:"varint,3,opt,name=errDisabled,proto3" json:"errDisabled,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrDisableEvent) Reset()         { *m = ErrDisableEvent{} }
+func (m *ErrDisableEvent) String() string { return proto.CompactTextString(m) }
+func (*ErrDisableEvent) ProtoMessage()    {}
+func (*ErrDisableEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{3}
+}
+
+func (m *ErrDisableEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrDisableEvent.Unmarshal(m, b)
+}
+func (m *ErrDisableEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrDisableEvent.Marshal(b, m, deterministic)
+}
+func (m *ErrDisableEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrDisableEvent.Merge(m, src)
+}
+func (m *ErrDisableEvent) XXX_Size() int {
+	return xxx_messageInfo_ErrDisableEvent.Size(m)
+}
+func (m *ErrDisableEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrDisableEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrDisableEvent proto.InternalMessageInfo
+
+func (m *ErrDisableEvent) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *ErrDisableEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ErrDisableEvent) GetErrDisabled() bool {
+	if m != nil {
+		return m.ErrDisabled
+	}
+	return false
+}
+
+func (m *ErrDisableEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type BpduResult struct {
+	Result               BpduResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.Bpdu.BpduResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BpduResult) Reset()         { *m = BpduResult{} }
+func (m *BpduResult) String() string { return proto.CompactTextString(m) }
+func (*BpduResult) ProtoMessage()    {}
+func (*BpduResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce035197e7670e, []int{4}
+}
+
+func (m *BpduResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BpduResult.Unmarshal(m, b)
+}
+func (m *BpduResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BpduResult.Marshal(b, m, deterministic)
+}
+func (m *BpduResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BpduResult.Merge(m, src)
+}
+func (m *BpduResult) XXX_Size() int {
+	return xxx_messageInfo_BpduResult.Size(m)
+}
+func (m *BpduResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BpduResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BpduResult proto.InternalMessageInfo
+
+func (m *BpduResult) GetResult() BpduResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return BpduResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.Bpdu.BpduResult_Result", BpduResult_Result_name, BpduResult_Result_value)
+	proto.RegisterType((*BpduIface)(nil), "OpenNos.Switch.Bpdu.BpduIface")
+	proto.RegisterType((*BpduProtectionConfig)(nil), "OpenNos.Switch.Bpdu.BpduProtectionConfig")
+	proto.RegisterType((*BpduProtectionStatus)(nil), "OpenNos.Switch.Bpdu.BpduProtectionStatus")
+	proto.RegisterType((*ErrDisableEvent)(nil), "OpenNos.Switch.Bpdu.ErrDisableEvent")
+	proto.RegisterType((*BpduResult)(nil), "OpenNos.Switch.Bpdu.BpduResult")
+}
+
+func init() { proto.RegisterFile("bpdu_protection.proto", fileDescriptor_e7ce035197e7670e) }
+
+var fileDescriptor_e7ce035197e7670e = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6f, 0xd3, 0x30,
+	0x10, 0xc7, 0x6b, 0x3a, 0x02, 0xb9, 0x8a, 0xfd, 0x30, 0x1b, 0xaa, 0x26, 0x04, 0xc5, 0x20, 0x54,
+	0x5e, 0x22, 0x54, 0x5e, 0x11, 0xd2, 0xe8, 0x0a, 0x9a, 0x84, 0xa0, 0x72, 0x04, 0x3c, 0x82, 0x9b,
+	0x5e, 0x37, 0x4b, 0x69, 0x1c, 0xd9, 0xce, 0x10, 0x8f, 0xbc, 0xf2, 0x7f, 0xf0, 0xc6, 0x1f, 0x89,
+	0xec, 0x98, 0x0e, 0xd2, 0x55, 0xe9, 0x93, 0x7d, 0xdf, 0xf6, 0x7b, 0xf7, 0xb9, 0xf3, 0x29, 0x70,
+	0x34, 0x2b, 0xe7, 0xd5, 0x97, 0x52, 0x2b, 0x8b, 0x99, 0x95, 0xaa, 0x48, 0xdc, 0x55, 0xd1, 0xbb,
+	0x1f, 0x4a, 0x2c, 0xde, 0x2b, 0x93, 0xa4, 0xdf, 0xa4, 0xcd, 0x2e, 0x92, 0xd7, 0xe5, 0xbc, 0x62,
+	0x8f, 0x21, 0x76, 0xe7, 0xd9, 0x42, 0x64, 0x48, 0xef, 0x41, 0x24, 0x17, 0x85, 0x58, 0x62, 0x9f,
+	0x0c, 0xc8, 0x30, 0xe6, 0x21, 0x62, 0xbf, 0x09, 0x1c, 0xba, 0x7f, 0x4d, 0x57, 0x29, 0xc7, 0xaa,
+	0x58, 0xc8, 0x73, 0xfa, 0x12, 0x62, 0x59, 0x58, 0xd4, 0xce, 0xed, 0x3d, 0xbd, 0xd1, 0x83, 0xe4,
+	0x9a, 0x32, 0xc9, 0xaa, 0x06, 0xbf, 0x32, 0xd0, 0x43, 0xb8, 0x79, 0x5e, 0x09, 0x3d, 0xef, 0xdf,
+	0x18, 0x90, 0xe1, 0x6d, 0x5e, 0x07, 0x0e, 0x62, 0x21, 0x73, 0x8b, 0xba, 0xdf, 0xf5, 0x72, 0x88,
+	0xe8, 0x10, 0xf6, 0x44, 0x65, 0x15, 0xc7, 0x4c, 0x5d, 0xa2, 0xfe, 0x9e, 0x62, 0xd6, 0xdf, 0x19,
+	0x90, 0xe1, 0x1d, 0xde, 0x94, 0xd9, 0xaf, 0x35, 0xdc, 0xd4, 0x0a, 0x5b, 0x19, 0x7a, 0x02, 0x51,
+	0xe6, 0xc1, 0x03, 0xeb, 0xb3, 0x8d, 0xac, 0xcd, 0x4e, 0x79, 0x30, 0xd2, 0x01, 0xf4, 0x50, 0xeb,
+	0x53, 0x69, 0xc4, 0x2c, 0xc7, 0xbf, 0xe4, 0xff, 0x4a, 0x8e, 0xd3, 0x37, 0xf2, 0x49, 0xaa, 0x5c,
+	0xb8, 0x0c, 0xc6, 0x37, 0xb2, 0xc3, 0x9b, 0x32, 0xfb, 0x41, 0x60, 0x6f, 0xb2, 0x72, 0x4e, 0x2e,
+	0xb1, 0xb0, 0x9b, 0x9e, 0xc0, 0xe9, 0x1a, 0x85, 0x51, 0x85, 0x2f, 0x19, 0xf3, 0x10, 0x35, 0x79,
+	0xba, 0xeb, 0x3c, 0xf7, 0x21, 0xb6, 0x72, 0x89, 0xc6, 0x8a, 0x65, 0xe9, 0x27, 0xd6, 0xe5, 0x57,
+	0x02, 0x53, 0x00, 0xae, 0x5f, 0x8e, 0xa6, 0xca, 0x2d, 0x7d, 0xe5, 0xaa, 0xb8, 0x9b, 0xaf, 0xbe,
+	0x3b, 0x7a, 0xba, 0x71, 0x40, 0xb5, 0x21, 0xa9, 0x0f, 0x1e, 0x5c, 0xec, 0x11, 0x44, 0x21, 0x13,
+	0x40, 0xf4, 0xe6, 0xe4, 0xec, 0xdd, 0xe4, 0x74, 0xbf, 0x43, 0x7b, 0x70, 0x2b, 0xfd, 0x38, 0x1e,
+	0x4f, 0xd2, 0x74, 0x9f, 0x8c, 0x7e, 0x76, 0x61, 0xf7, 0xff, 0x09, 0x53, 0x01, 0x07, 0x29, 0xda,
+	0x86, 0xb8, 0xfd, 0xdb, 0x1c, 0x3f, 0x6c, 0xa1, 0x64, 0x1d, 0xfa, 0x15, 0x0e, 0xde, 0xae, 0x95,
+	0x68, 0x59, 0xd5, 0xe3, 0x6d, 0x10, 0xea, 0xcd, 0x62, 0x1d, 0x3a, 0x85, 0x5e, 0xd8, 0xc1, 0xa9,
+	0xd2, 0xb6, 0x35, 0xf7, 0x16, 0xcc, 0x02, 0x8e, 0x3e, 0x0b, 0x9b, 0x5d, 0x34, 0x56, 0xc4, 0xb4,
+	0xe6, 0x7e, 0x72, 0xed, 0xef, 0x8d, 0x34, 0xac, 0xf3, 0x9c, 0xcc, 0x22, 0xff, 0x65, 0x78, 0xf1,
+	0x67, 0x00, 0xa1, 0x1f, 0x0a, 0xa2, 0x32, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BpduProtectionClient is the client API for BpduProtection service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BpduProtectionClient interface {
+	SetBpduProtection(ctx context.Context, in *BpduProtectionConfig, opts ...grpc.CallOption) (*BpduResult, error)
+	GetBpduProtection(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (*BpduProtectionStatus, error)
+	RecoverPort(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (*BpduResult, error)
+	WatchErrDisableEvents(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (BpduProtection_WatchErrDisableEventsClient, error)
+}
+
+type bpduProtectionClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBpduProtectionClient(cc *grpc.ClientConn) BpduProtectionClient {
+	return &bpduProtectionClient{cc}
+}
+
+func (c *bpduProtectionClient) SetBpduProtection(ctx context.Context, in *BpduProtectionConfig, opts ...grpc.CallOption) (*BpduResult, error) {
+	out := new(BpduResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Bpdu.BpduProtection/SetBpduProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bpduProtectionClient) GetBpduProtection(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (*BpduProtectionStatus, error) {
+	out := new(BpduProtectionStatus)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Bpdu.BpduProtection/GetBpduProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bpduProtectionClient) RecoverPort(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (*BpduResult, error) {
+	out := new(BpduResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Bpdu.BpduProtection/RecoverPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bpduProtectionClient) WatchErrDisableEvents(ctx context.Context, in *BpduIface, opts ...grpc.CallOption) (BpduProtection_WatchErrDisableEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BpduProtection_serviceDesc.Streams[0], "/OpenNos.Switch.Bpdu.BpduProtection/WatchErrDisableEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bpduProtectionWatchErrDisableEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BpduProtection_WatchErrDisableEventsClient interface {
+	Recv() (*ErrDisableEvent, error)
+	grpc.ClientStream
+}
+
+type bpduProtectionWatchErrDisableEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bpduProtectionWatchErrDisableEventsClient) Recv() (*ErrDisableEvent, error) {
+	m := new(ErrDisableEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BpduProtectionServer is the server API for BpduProtection service.
+type BpduProtectionServer interface {
+	SetBpduProtection(context.Context, *BpduProtectionConfig) (*BpduResult, error)
+	GetBpduProtection(context.Context, *BpduIface) (*BpduProtectionStatus, error)
+	RecoverPort(context.Context, *BpduIface) (*BpduResult, error)
+	WatchErrDisableEvents(*BpduIface, BpduProtection_WatchErrDisableEventsServer) error
+}
+
+// UnimplementedBpduProtectionServer can be embedded to have forward compatible implementations.
+type UnimplementedBpduProtectionServer struct {
+}
+
+func (*UnimplementedBpduProtectionServer) SetBpduProtection(ctx context.Context, req *BpduProtectionConfig) (*BpduResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBpduProtection not implemented")
+}
+func (*UnimplementedBpduProtectionServer) GetBpduProtection(ctx context.Context, req *BpduIface) (*BpduProtectionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBpduProtection not implemented")
+}
+func (*UnimplementedBpduProtectionServer) RecoverPort(ctx context.Context, req *BpduIface) (*BpduResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPort not implemented")
+}
+func (*UnimplementedBpduProtectionServer) WatchErrDisableEvents(req *BpduIface, srv BpduProtection_WatchErrDisableEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchErrDisableEvents not implemented")
+}
+
+func RegisterBpduProtectionServer(s *grpc.Server, srv BpduProtectionServer) {
+	s.RegisterService(&_BpduProtection_serviceDesc, srv)
+}
+
+func _BpduProtection_SetBpduProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BpduProtectionConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpduProtectionServer).SetBpduProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Bpdu.BpduProtection/SetBpduProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpduProtectionServer).SetBpduProtection(ctx, req.(*BpduProtectionConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BpduProtection_GetBpduProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BpduIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpduProtectionServer).GetBpduProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Bpdu.BpduProtection/GetBpduProtection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpduProtectionServer).GetBpduProtection(ctx, req.(*BpduIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BpduProtection_RecoverPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BpduIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpduProtectionServer).RecoverPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Bpdu.BpduProtection/RecoverPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpduProtectionServer).RecoverPort(ctx, req.(*BpduIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BpduProtection_WatchErrDisableEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BpduIface)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BpduProtectionServer).WatchErrDisableEvents(m, &bpduProtectionWatchErrDisableEventsServer{stream})
+}
+
+type BpduProtection_WatchErrDisableEventsServer interface {
+	Send(*ErrDisableEvent) error
+	grpc.ServerStream
+}
+
+type bpduProtectionWatchErrDisableEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bpduProtectionWatchErrDisableEventsServer) Send(m *ErrDisableEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BpduProtection_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.Bpdu.BpduProtection",
+	HandlerType: (*BpduProtectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetBpduProtection",
+			Handler:    _BpduProtection_SetBpduProtection_Handler,
+		},
+		{
+			MethodName: "GetBpduProtection",
+			Handler:    _BpduProtection_GetBpduProtection_Handler,
+		},
+		{
+			MethodName: "RecoverPort",
+			Handler:    _BpduProtection_RecoverPort_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchErrDisableEvents",
+			Handler:       _BpduProtection_WatchErrDisableEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bpdu_protection.proto",
+}
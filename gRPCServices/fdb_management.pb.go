@@ -0,0 +1,1291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fdb_management.proto
+
+package OpenNos_Switch_Fdb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type FdbEvent_Type int32
+
+const (
+	FdbEvent_LEARN FdbEvent_Type = 0
+	FdbEvent_AGE   FdbEvent_Type = 1
+	FdbEvent_MOVE  FdbEvent_Type = 2
+)
+
+var FdbEvent_Type_name = map[int32]string{
+	0: "LEARN",
+	1: "AGE",
+	2: "MOVE",
+}
+
+var FdbEvent_Type_value = map[string]int32{
+	"LEARN": 0,
+	"AGE":   1,
+	"MOVE":  2,
+}
+
+func (x FdbEvent_Type) String() string {
+	return proto.EnumName(FdbEvent_Type_name, int32(x))
+}
+
+func (FdbEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{3, 0}
+}
+
+type FdbResult_Result int32
+
+const (
+	FdbResult_FAILED  FdbResult_Result = 0
+	FdbResult_SUCCESS FdbResult_Result = 1
+)
+
+var FdbResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var FdbResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x FdbResult_Result) String() string {
+	return proto.EnumName(FdbResult_Result_name, int32(x))
+}
+
+func (FdbResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{11, 0}
+}
+
+type FdbEntry struct {
+	Mac  string `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Vlan uint32 `protobuf:"varint,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// Port or LAG the address points at, it can be empty for blackhole entry
+	Ifname               string   `protobuf:"bytes,3,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Static               bool     `protobuf:"varint,4,opt,name=static,proto3" json:"static,omitempty"`
+	Blackhole            bool     `protobuf:"varint,5,opt,name=blackhole,proto3" json:"blackhole,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FdbEntry) Reset()         { *m = FdbEntry{} }
+func (m *FdbEntry) String() string { return proto.CompactTextString(m) }
+func (*FdbEntry) ProtoMessage()    {}
+func (*FdbEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{0}
+}
+
+func (m *FdbEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbEntry.Unmarshal(m, b)
+}
+func (m *FdbEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbEntry.Marshal(b, m, deterministic)
+}
+func (m *FdbEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbEntry.Merge(m, src)
+}
+func (m *FdbEntry) XXX_Size() int {
+	return xxx_messageInfo_FdbEntry.Size(m)
+}
+func (m *FdbEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbEntry proto.InternalMessageInfo
+
+func (m *FdbEntry) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *FdbEntry) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *FdbEntry) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *FdbEntry) GetStatic() bool {
+	if m != nil {
+		return m.Static
+	}
+	return false
+}
+
+func (m *FdbEntry) GetBlackhole() bool {
+	if m != nil {
+		return m.Blackhole
+	}
+	return false
+}
+
+type FdbFilter struct {
+	// Port or LAG, empty matches any interface
+	Ifname string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	// Zero matches any VLAN
+	Vlan uint32 `protobuf:"varint,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// Empty matches any MAC address
+	Mac                  string   `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FdbFilter) Reset()         { *m = FdbFilter{} }
+func (m *FdbFilter) String() string { return proto.CompactTextString(m) }
+func (*FdbFilter) ProtoMessage()    {}
+func (*FdbFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{1}
+}
+
+func (m *FdbFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbFilter.Unmarshal(m, b)
+}
+func (m *FdbFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbFilter.Marshal(b, m, deterministic)
+}
+func (m *FdbFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbFilter.Merge(m, src)
+}
+func (m *FdbFilter) XXX_Size() int {
+	return xxx_messageInfo_FdbFilter.Size(m)
+}
+func (m *FdbFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbFilter proto.InternalMessageInfo
+
+func (m *FdbFilter) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *FdbFilter) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *FdbFilter) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+type FdbEntries struct {
+	Entries              []*FdbEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FdbEntries) Reset()         { *m = FdbEntries{} }
+func (m *FdbEntries) String() string { return proto.CompactTextString(m) }
+func (*FdbEntries) ProtoMessage()    {}
+func (*FdbEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{2}
+}
+
+func (m *FdbEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbEntries.Unmarshal(m, b)
+}
+func (m *FdbEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbEntries.Marshal(b, m, deterministic)
+}
+func (m *FdbEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbEntries.Merge(m, src)
+}
+func (m *FdbEntries) XXX_Size() int {
+	return xxx_messageInfo_FdbEntries.Size(m)
+}
+func (m *FdbEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbEntries proto.InternalMessageInfo
+
+func (m *FdbEntries) GetEntries() []*FdbEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type FdbEvent struct {
+	Type   FdbEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=OpenNos.Switch.Fdb.FdbEvent_Type" json:"type,omitempty"`
+	Mac    string        `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`
+	Vlan   uint32        `protobuf:"varint,3,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Ifname string        `protobuf:"bytes,4,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	// Interface the address has been moved from
+	PrevIfname           string   `protobuf:"bytes,5,opt,name=prevIfname,proto3" json:"prevIfname,omitempty"`
+	Timestamp            int64    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FdbEvent) Reset()         { *m = FdbEvent{} }
+func (m *FdbEvent) String() string { return proto.CompactTextString(m) }
+func (*FdbEvent) ProtoMessage()    {}
+func (*FdbEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{3}
+}
+
+func (m *FdbEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbEvent.Unmarshal(m, b)
+}
+func (m *FdbEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbEvent.Marshal(b, m, deterministic)
+}
+func (m *FdbEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbEvent.Merge(m, src)
+}
+func (m *FdbEvent) XXX_Size() int {
+	return xxx_messageInfo_FdbEvent.Size(m)
+}
+func (m *FdbEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbEvent proto.InternalMessageInfo
+
+func (m *FdbEvent) GetType() FdbEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return FdbEvent_LEARN
+}
+
+func (m *FdbEvent) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *FdbEvent) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *FdbEvent) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *FdbEvent) GetPrevIfname() string {
+	if m != nil {
+		return m.PrevIfname
+	}
+	return ""
+}
+
+func (m *FdbEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type MacFlapDetection struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Number of moves of the same address within the window which is reported as flapping
+	MaxMoves             uint32   `protobuf:"varint,2,opt,name=maxMoves,proto3" json:"maxMoves,omitempty"`
+	WindowSec            uint32   `protobuf:"varint,3,opt,name=windowSec,proto3" json:"windowSec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacFlapDetection) Reset()         { *m = MacFlapDetection{} }
+func (m *MacFlapDetection) String() string { return proto.CompactTextString(m) }
+func (*MacFlapDetection) ProtoMessage()    {}
+func (*MacFlapDetection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{4}
+}
+
+func (m *MacFlapDetection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacFlapDetection.Unmarshal(m, b)
+}
+func (m *MacFlapDetection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacFlapDetection.Marshal(b, m, deterministic)
+}
+func (m *MacFlapDetection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacFlapDetection.Merge(m, src)
+}
+func (m *MacFlapDetection) XXX_Size() int {
+	return xxx_messageInfo_MacFlapDetection.Size(m)
+}
+func (m *MacFlapDetection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacFlapDetection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacFlapDetection proto.InternalMessageInfo
+
+func (m *MacFlapDetection) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MacFlapDetection) GetMaxMoves() uint32 {
+	if m != nil {
+		return m.MaxMoves
+	}
+	return 0
+}
+
+func (m *MacFlapDetection) GetWindowSec() uint32 {
+	if m != nil {
+		return m.WindowSec
+	}
+	return 0
+}
+
+type MacFlapPortAction struct {
+	Ifname string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	// Block port through STG when address flaps over it
+	Block bool `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Period after which blocked port is unblocked. Zero keeps it blocked until STP changes its state or it is
+	// unblocked manually.
+	BlockSec             uint32   `protobuf:"varint,3,opt,name=blockSec,proto3" json:"blockSec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacFlapPortAction) Reset()         { *m = MacFlapPortAction{} }
+func (m *MacFlapPortAction) String() string { return proto.CompactTextString(m) }
+func (*MacFlapPortAction) ProtoMessage()    {}
+func (*MacFlapPortAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{5}
+}
+
+func (m *MacFlapPortAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacFlapPortAction.Unmarshal(m, b)
+}
+func (m *MacFlapPortAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacFlapPortAction.Marshal(b, m, deterministic)
+}
+func (m *MacFlapPortAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacFlapPortAction.Merge(m, src)
+}
+func (m *MacFlapPortAction) XXX_Size() int {
+	return xxx_messageInfo_MacFlapPortAction.Size(m)
+}
+func (m *MacFlapPortAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacFlapPortAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacFlapPortAction proto.InternalMessageInfo
+
+func (m *MacFlapPortAction) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *MacFlapPortAction) GetBlock() bool {
+	if m != nil {
+		return m.Block
+	}
+	return false
+}
+
+func (m *MacFlapPortAction) GetBlockSec() uint32 {
+	if m != nil {
+		return m.BlockSec
+	}
+	return 0
+}
+
+type MacFlapIface struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacFlapIface) Reset()         { *m = MacFlapIface{} }
+func (m *MacFlapIface) String() string { return proto.CompactTextString(m) }
+func (*MacFlapIface) ProtoMessage()    {}
+func (*MacFlapIface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{6}
+}
+
+func (m *MacFlapIface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacFlapIface.Unmarshal(m, b)
+}
+func (m *MacFlapIface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacFlapIface.Marshal(b, m, deterministic)
+}
+func (m *MacFlapIface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacFlapIface.Merge(m, src)
+}
+func (m *MacFlapIface) XXX_Size() int {
+	return xxx_messageInfo_MacFlapIface.Size(m)
+}
+func (m *MacFlapIface) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacFlapIface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacFlapIface proto.InternalMessageInfo
+
+func (m *MacFlapIface) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+type MacFlapEvent struct {
+	Mac     string   `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Vlan    uint32   `protobuf:"varint,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Ifnames []string `protobuf:"bytes,3,rep,name=ifnames,proto3" json:"ifnames,omitempty"`
+	Moves   uint32   `protobuf:"varint,4,opt,name=moves,proto3" json:"moves,omitempty"`
+	// Interface blocked because of the flapping, empty if none
+	BlockedIfname        string   `protobuf:"bytes,5,opt,name=blockedIfname,proto3" json:"blockedIfname,omitempty"`
+	Timestamp            int64    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacFlapEvent) Reset()         { *m = MacFlapEvent{} }
+func (m *MacFlapEvent) String() string { return proto.CompactTextString(m) }
+func (*MacFlapEvent) ProtoMessage()    {}
+func (*MacFlapEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{7}
+}
+
+func (m *MacFlapEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacFlapEvent.Unmarshal(m, b)
+}
+func (m *MacFlapEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacFlapEvent.Marshal(b, m, deterministic)
+}
+func (m *MacFlapEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacFlapEvent.Merge(m, src)
+}
+func (m *MacFlapEvent) XXX_Size() int {
+	return xxx_messageInfo_MacFlapEvent.Size(m)
+}
+func (m *MacFlapEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacFlapEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacFlapEvent proto.InternalMessageInfo
+
+func (m *MacFlapEvent) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *MacFlapEvent) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *MacFlapEvent) GetIfnames() []string {
+	if m != nil {
+		return m.Ifnames
+	}
+	return nil
+}
+
+func (m *MacFlapEvent) GetMoves() uint32 {
+	if m != nil {
+		return m.Moves
+	}
+	return 0
+}
+
+func (m *MacFlapEvent) GetBlockedIfname() string {
+	if m != nil {
+		return m.BlockedIfname
+	}
+	return ""
+}
+
+func (m *MacFlapEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type MacMoveStats struct {
+	Mac                  string   `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Vlan                 uint32   `protobuf:"varint,2,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	Moves                uint64   `protobuf:"varint,3,opt,name=moves,proto3" json:"moves,omitempty"`
+	Flaps                uint64   `protobuf:"varint,4,opt,name=flaps,proto3" json:"flaps,omitempty"`
+	LastFromIfname       string   `protobuf:"bytes,5,opt,name=lastFromIfname,proto3" json:"lastFromIfname,omitempty"`
+	LastToIfname         string   `protobuf:"bytes,6,opt,name=lastToIfname,proto3" json:"lastToIfname,omitempty"`
+	LastMoveTimestamp    int64    `protobuf:"varint,7,opt,name=lastMoveTimestamp,proto3" json:"lastMoveTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MacMoveStats) Reset()         { *m = MacMoveStats{} }
+func (m *MacMoveStats) String() string { return proto.CompactTextString(m) }
+func (*MacMoveStats) ProtoMessage()    {}
+func (*MacMoveStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{8}
+}
+
+func (m *MacMoveStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacMoveStats.Unmarshal(m, b)
+}
+func (m *MacMoveStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacMoveStats.Marshal(b, m, deterministic)
+}
+func (m *MacMoveStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacMoveStats.Merge(m, src)
+}
+func (m *MacMoveStats) XXX_Size() int {
+	return xxx_messageInfo_MacMoveStats.Size(m)
+}
+func (m *MacMoveStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacMoveStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacMoveStats proto.InternalMessageInfo
+
+func (m *MacMoveStats) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *MacMoveStats) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *MacMoveStats) GetMoves() uint64 {
+	if m != nil {
+		return m.Moves
+	}
+	return 0
+}
+
+func (m *MacMoveStats) GetFlaps() uint64 {
+	if m != nil {
+		return m.Flaps
+	}
+	return 0
+}
+
+func (m *MacMoveStats) GetLastFromIfname() string {
+	if m != nil {
+		return m.LastFromIfname
+	}
+	return ""
+}
+
+func (m *MacMoveStats) GetLastToIfname() string {
+	if m != nil {
+		return m.LastToIfname
+	}
+	return ""
+}
+
+func (m *MacMoveStats) GetLastMoveTimestamp() int64 {
+	if m != nil {
+		return m.LastMoveTimestamp
+	}
+	return 0
+}
+
+type MacMoveStatsList struct {
+	Stats                []*MacMoveStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MacMoveStatsList) Reset()         { *m = MacMoveStatsList{} }
+func (m *MacMoveStatsList) String() string { return proto.CompactTextString(m) }
+func (*MacMoveStatsList) ProtoMessage()    {}
+func (*MacMoveStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{9}
+}
+
+func (m *MacMoveStatsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MacMoveStatsList.Unmarshal(m, b)
+}
+func (m *MacMoveStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MacMoveStatsList.Marshal(b, m, deterministic)
+}
+func (m *MacMoveStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MacMoveStatsList.Merge(m, src)
+}
+func (m *MacMoveStatsList) XXX_Size() int {
+	return xxx_messageInfo_MacMoveStatsList.Size(m)
+}
+func (m *MacMoveStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MacMoveStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MacMoveStatsList proto.InternalMessageInfo
+
+func (m *MacMoveStatsList) GetStats() []*MacMoveStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type FdbEmpty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FdbEmpty) Reset()         { *m = FdbEmpty{} }
+func (m *FdbEmpty) String() string { return proto.CompactTextString(m) }
+func (*FdbEmpty) ProtoMessage()    {}
+func (*FdbEmpty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{10}
+}
+
+func (m *FdbEmpty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbEmpty.Unmarshal(m, b)
+}
+func (m *FdbEmpty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbEmpty.Marshal(b, m, deterministic)
+}
+func (m *FdbEmpty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbEmpty.Merge(m, src)
+}
+func (m *FdbEmpty) XXX_Size() int {
+	return xxx_messageInfo_FdbEmpty.Size(m)
+}
+func (m *FdbEmpty) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbEmpty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbEmpty proto.InternalMessageInfo
+
+type FdbResult struct {
+	Result               FdbResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.Fdb.FdbResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *FdbResult) Reset()         { *m = FdbResult{} }
+func (m *FdbResult) String() string { return proto.CompactTextString(m) }
+func (*FdbResult) ProtoMessage()    {}
+func (*FdbResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55aeaf3f8b25b0e1, []int{11}
+}
+
+func (m *FdbResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FdbResult.Unmarshal(m, b)
+}
+func (m *FdbResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FdbResult.Marshal(b, m, deterministic)
+}
+func (m *FdbResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FdbResult.Merge(m, src)
+}
+func (m *FdbResult) XXX_Size() int {
+	return xxx_messageInfo_FdbResult.Size(m)
+}
+func (m *FdbResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FdbResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FdbResult proto.InternalMessageInfo
+
+func (m *FdbResult) GetResult() FdbResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return FdbResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.Fdb.FdbEvent_Type", FdbEvent_Type_name, FdbEvent_Type_value)
+	proto.RegisterEnum("OpenNos.Switch.Fdb.FdbResult_Result", FdbResult_Result_name, FdbResult_Result_value)
+	proto.RegisterType((*FdbEntry)(nil), "OpenNos.Switch.Fdb.FdbEntry")
+	proto.RegisterType((*FdbFilter)(nil), "OpenNos.Switch.Fdb.FdbFilter")
+	proto.RegisterType((*FdbEntries)(nil), "OpenNos.Switch.Fdb.FdbEntries")
+	proto.RegisterType((*FdbEvent)(nil), "OpenNos.Switch.Fdb.FdbEvent")
+	proto.RegisterType((*MacFlapDetection)(nil), "OpenNos.Switch.Fdb.MacFlapDetection")
+	proto.RegisterType((*MacFlapPortAction)(nil), "OpenNos.Switch.Fdb.MacFlapPortAction")
+	proto.RegisterType((*MacFlapIface)(nil), "OpenNos.Switch.Fdb.MacFlapIface")
+	proto.RegisterType((*MacFlapEvent)(nil), "OpenNos.Switch.Fdb.MacFlapEvent")
+	proto.RegisterType((*MacMoveStats)(nil), "OpenNos.Switch.Fdb.MacMoveStats")
+	proto.RegisterType((*MacMoveStatsList)(nil), "OpenNos.Switch.Fdb.MacMoveStatsList")
+	proto.RegisterType((*FdbEmpty)(nil), "OpenNos.Switch.Fdb.FdbEmpty")
+	proto.RegisterType((*FdbResult)(nil), "OpenNos.Switch.Fdb.FdbResult")
+}
+
+func init() { proto.RegisterFile("fdb_management.proto", fileDescriptor_55aeaf3f8b25b0e1) }
+
+var fileDescriptor_55aeaf3f8b25b0e1 = []byte{
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xe3, 0x36,
+	0x14, 0xb5, 0x22, 0x59, 0xb6, 0xaf, 0x93, 0xc0, 0xc3, 0x04, 0x85, 0x10, 0x64, 0x06, 0x1a, 0xc2,
+	0x1d, 0x78, 0x51, 0x18, 0x45, 0x8a, 0xce, 0xaa, 0x1b, 0x63, 0x62, 0x0d, 0x52, 0xd8, 0x4e, 0x21,
+	0x39, 0x6d, 0x81, 0xbe, 0x40, 0x49, 0x74, 0x23, 0x44, 0x2f, 0x58, 0xac, 0x53, 0x6f, 0xfa, 0x3d,
+	0xfd, 0xa6, 0x6e, 0xfb, 0x03, 0xfd, 0x84, 0x82, 0xa4, 0x24, 0x5b, 0xf5, 0x43, 0x59, 0x74, 0x65,
+	0xde, 0xab, 0xc3, 0xcb, 0x73, 0x2e, 0x79, 0x0f, 0x0c, 0x97, 0x0b, 0xdf, 0xfd, 0x25, 0x22, 0x31,
+	0xf9, 0x95, 0x46, 0x34, 0x66, 0xc3, 0x74, 0x99, 0xb0, 0x04, 0xa1, 0xfb, 0x94, 0xc6, 0xb3, 0x24,
+	0x1b, 0x3a, 0xcf, 0x01, 0xf3, 0x1e, 0x87, 0x96, 0xef, 0xe2, 0x3f, 0xa0, 0x6d, 0xf9, 0xee, 0x38,
+	0x66, 0xcb, 0x35, 0xea, 0x81, 0x1a, 0x11, 0xcf, 0x50, 0x4c, 0x65, 0xd0, 0xb1, 0xf9, 0x12, 0x21,
+	0xd0, 0x56, 0x21, 0x89, 0x8d, 0x13, 0x53, 0x19, 0x9c, 0xd9, 0x62, 0x8d, 0x3e, 0x01, 0x3d, 0x58,
+	0xc4, 0x24, 0xa2, 0x86, 0x2a, 0x80, 0x79, 0xc4, 0xf3, 0x19, 0x23, 0x2c, 0xf0, 0x0c, 0xcd, 0x54,
+	0x06, 0x6d, 0x3b, 0x8f, 0xd0, 0x35, 0x74, 0xdc, 0x90, 0x78, 0x4f, 0x8f, 0x49, 0x48, 0x8d, 0xa6,
+	0xf8, 0xb4, 0x49, 0xe0, 0x3b, 0xe8, 0x58, 0xbe, 0x6b, 0x05, 0x21, 0xa3, 0xcb, 0xad, 0xd2, 0x4a,
+	0xa5, 0xf4, 0x3e, 0x1a, 0x39, 0x59, 0xb5, 0x24, 0x8b, 0x6f, 0x01, 0x72, 0x29, 0x01, 0xcd, 0xd0,
+	0x7b, 0x68, 0x51, 0xb9, 0x34, 0x14, 0x53, 0x1d, 0x74, 0x6f, 0xae, 0x87, 0xbb, 0xf2, 0x87, 0x85,
+	0x76, 0xbb, 0x00, 0xe3, 0xbf, 0x15, 0xd9, 0x91, 0x15, 0x8d, 0x19, 0xfa, 0x12, 0x34, 0xb6, 0x4e,
+	0x25, 0x9d, 0xf3, 0x9b, 0xb7, 0x87, 0x2a, 0x70, 0xec, 0x70, 0xbe, 0x4e, 0xa9, 0x2d, 0xe0, 0x05,
+	0xb7, 0x93, 0xdd, 0x46, 0xaa, 0x7b, 0x1b, 0xa9, 0x55, 0xd4, 0xbe, 0x01, 0x48, 0x97, 0x74, 0x75,
+	0x27, 0xbf, 0x35, 0xc5, 0xb7, 0xad, 0x0c, 0x6f, 0x28, 0x0b, 0x22, 0x9a, 0x31, 0x12, 0xa5, 0x86,
+	0x6e, 0x2a, 0x03, 0xd5, 0xde, 0x24, 0x70, 0x1f, 0x34, 0xce, 0x04, 0x75, 0xa0, 0x39, 0x19, 0x8f,
+	0xec, 0x59, 0xaf, 0x81, 0x5a, 0xa0, 0x8e, 0x3e, 0x8e, 0x7b, 0x0a, 0x6a, 0x83, 0x36, 0xbd, 0xff,
+	0x76, 0xdc, 0x3b, 0xc1, 0x0b, 0xe8, 0x4d, 0x89, 0x67, 0x85, 0x24, 0xbd, 0xa5, 0x8c, 0x7a, 0x2c,
+	0x48, 0x62, 0x64, 0xf0, 0x8e, 0x11, 0x37, 0xa4, 0xbe, 0xd0, 0xdb, 0xb6, 0x8b, 0x10, 0x5d, 0x41,
+	0x3b, 0x22, 0xbf, 0x4f, 0x93, 0x15, 0xcd, 0xf2, 0x3b, 0x28, 0x63, 0xce, 0xe6, 0x39, 0x88, 0xfd,
+	0xe4, 0xd9, 0xa1, 0x5e, 0x2e, 0x6f, 0x93, 0xc0, 0x3f, 0xc1, 0xab, 0xfc, 0x9c, 0x6f, 0x92, 0x25,
+	0x1b, 0xc9, 0x83, 0x0e, 0x5d, 0xf3, 0x25, 0x34, 0xdd, 0x30, 0xf1, 0x9e, 0xc4, 0x19, 0x6d, 0x5b,
+	0x06, 0xfc, 0x70, 0xb1, 0xd8, 0xd4, 0x2f, 0x63, 0xfc, 0x0e, 0x4e, 0xf3, 0xf2, 0x77, 0x0b, 0xe2,
+	0xd1, 0x43, 0x95, 0xf1, 0x9f, 0x4a, 0x09, 0x94, 0x17, 0xfb, 0xb2, 0xa7, 0x6e, 0x40, 0x4b, 0x16,
+	0xc8, 0x0c, 0xd5, 0x54, 0x07, 0x1d, 0xbb, 0x08, 0x39, 0xd5, 0x48, 0xb4, 0x43, 0x13, 0x70, 0x19,
+	0xa0, 0x3e, 0x9c, 0x09, 0x6a, 0xd4, 0xaf, 0x5c, 0x5e, 0x35, 0x59, 0x73, 0x7f, 0x7f, 0x49, 0xaa,
+	0xbc, 0xb9, 0x0e, 0x23, 0x2c, 0x7b, 0x21, 0xd5, 0x92, 0x10, 0x6f, 0x91, 0x56, 0x10, 0xba, 0x84,
+	0xe6, 0x22, 0x24, 0xa9, 0xa4, 0xa9, 0xd9, 0x32, 0x40, 0xef, 0xe0, 0x3c, 0x24, 0x19, 0xb3, 0x96,
+	0x49, 0x54, 0xe1, 0xf9, 0x9f, 0x2c, 0xc2, 0x70, 0xca, 0x33, 0xf3, 0x24, 0x47, 0xe9, 0x02, 0x55,
+	0xc9, 0xa1, 0xcf, 0xe0, 0x15, 0x8f, 0x39, 0xdd, 0x79, 0x29, 0xaa, 0x25, 0x44, 0xed, 0x7e, 0xc0,
+	0x5f, 0x8b, 0x67, 0x57, 0x6a, 0x9b, 0x04, 0x19, 0x43, 0xef, 0xa1, 0xc9, 0x9d, 0xa2, 0x18, 0x53,
+	0x73, 0xdf, 0x90, 0x6d, 0x6f, 0xb2, 0x25, 0x1c, 0x83, 0x9c, 0xd3, 0x28, 0x65, 0x6b, 0x1c, 0x0a,
+	0x17, 0xb1, 0x69, 0xf6, 0x5b, 0xc8, 0xd0, 0x57, 0xa0, 0x2f, 0xc5, 0x2a, 0x1f, 0xdb, 0xfe, 0x81,
+	0xb1, 0x95, 0xf0, 0xa1, 0xfc, 0xb1, 0xf3, 0x3d, 0xf8, 0x2d, 0xe8, 0x79, 0x1d, 0x00, 0xdd, 0x1a,
+	0xdd, 0x4d, 0xc6, 0xb7, 0xbd, 0x06, 0xea, 0x42, 0xcb, 0x79, 0xf8, 0xf0, 0x61, 0xec, 0x38, 0x3d,
+	0xe5, 0xe6, 0x1f, 0x1d, 0xce, 0x2c, 0xdf, 0x9d, 0x96, 0xfe, 0x8a, 0x66, 0xd0, 0xe5, 0x5a, 0x0a,
+	0xef, 0x79, 0x7d, 0xe0, 0x44, 0x69, 0x73, 0x57, 0x6f, 0x8e, 0x38, 0x11, 0xb7, 0xa0, 0x06, 0xba,
+	0x87, 0xf3, 0x91, 0xef, 0x3b, 0xc2, 0x40, 0xa5, 0x37, 0x1f, 0x75, 0xaf, 0xab, 0xd7, 0x47, 0x25,
+	0xe2, 0x06, 0x9a, 0x40, 0xf7, 0x96, 0x86, 0x94, 0xd1, 0xff, 0xa5, 0xda, 0x0c, 0xba, 0xdf, 0x11,
+	0xe6, 0x3d, 0x8a, 0x59, 0xaa, 0x95, 0x7b, 0x7d, 0xcc, 0x36, 0x71, 0xe3, 0x73, 0x05, 0xfd, 0x08,
+	0x17, 0x0e, 0x65, 0x3b, 0x86, 0xd4, 0x3f, 0xf0, 0x14, 0x2a, 0xa8, 0x7a, 0xb6, 0x3f, 0xc0, 0xc5,
+	0xc7, 0x3d, 0xd5, 0x0f, 0xd2, 0xe2, 0x2f, 0xea, 0xea, 0x45, 0x67, 0xe3, 0x06, 0xfa, 0x19, 0x2e,
+	0x37, 0xd4, 0xb7, 0x3c, 0xee, 0xd3, 0x23, 0xfb, 0x37, 0xb0, 0x7a, 0xf2, 0x0f, 0x70, 0xca, 0xc9,
+	0x97, 0x6e, 0x50, 0xd3, 0xeb, 0x7e, 0xdd, 0xf4, 0xf0, 0x67, 0x8a, 0x1b, 0x68, 0x0e, 0x67, 0xe2,
+	0x06, 0x73, 0x46, 0xb5, 0x75, 0xcd, 0x23, 0x72, 0x36, 0xf7, 0xf8, 0x3d, 0x5c, 0x3c, 0xc4, 0xc2,
+	0xec, 0x2a, 0xae, 0x7c, 0x6c, 0xb3, 0x40, 0xd4, 0xb6, 0xc1, 0xd5, 0xc5, 0x3f, 0x98, 0x2f, 0xfe,
+	0x1d, 0x00, 0x05, 0xc6, 0x78, 0x70, 0xd9, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FdbManagementClient is the client API for FdbManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FdbManagementClient interface {
+	ListEntries(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (*FdbEntries, error)
+	AddStaticEntry(ctx context.Context, in *FdbEntry, opts ...grpc.CallOption) (*FdbResult, error)
+	DeleteEntry(ctx context.Context, in *FdbEntry, opts ...grpc.CallOption) (*FdbResult, error)
+	WatchEvents(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (FdbManagement_WatchEventsClient, error)
+	SetMacFlapDetection(ctx context.Context, in *MacFlapDetection, opts ...grpc.CallOption) (*FdbResult, error)
+	GetMacFlapDetection(ctx context.Context, in *FdbEmpty, opts ...grpc.CallOption) (*MacFlapDetection, error)
+	SetMacFlapPortAction(ctx context.Context, in *MacFlapPortAction, opts ...grpc.CallOption) (*FdbResult, error)
+	GetMoveStats(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (*MacMoveStatsList, error)
+	WatchMacFlaps(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (FdbManagement_WatchMacFlapsClient, error)
+	// Unblocks interface blocked because of flapping address, restoring its state before the block
+	UnblockMacFlapIface(ctx context.Context, in *MacFlapIface, opts ...grpc.CallOption) (*FdbResult, error)
+}
+
+type fdbManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFdbManagementClient(cc *grpc.ClientConn) FdbManagementClient {
+	return &fdbManagementClient{cc}
+}
+
+func (c *fdbManagementClient) ListEntries(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (*FdbEntries, error) {
+	out := new(FdbEntries)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/ListEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) AddStaticEntry(ctx context.Context, in *FdbEntry, opts ...grpc.CallOption) (*FdbResult, error) {
+	out := new(FdbResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/AddStaticEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) DeleteEntry(ctx context.Context, in *FdbEntry, opts ...grpc.CallOption) (*FdbResult, error) {
+	out := new(FdbResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/DeleteEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) WatchEvents(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (FdbManagement_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FdbManagement_serviceDesc.Streams[0], "/OpenNos.Switch.Fdb.FdbManagement/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fdbManagementWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FdbManagement_WatchEventsClient interface {
+	Recv() (*FdbEvent, error)
+	grpc.ClientStream
+}
+
+type fdbManagementWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fdbManagementWatchEventsClient) Recv() (*FdbEvent, error) {
+	m := new(FdbEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fdbManagementClient) SetMacFlapDetection(ctx context.Context, in *MacFlapDetection, opts ...grpc.CallOption) (*FdbResult, error) {
+	out := new(FdbResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/SetMacFlapDetection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) GetMacFlapDetection(ctx context.Context, in *FdbEmpty, opts ...grpc.CallOption) (*MacFlapDetection, error) {
+	out := new(MacFlapDetection)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/GetMacFlapDetection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) SetMacFlapPortAction(ctx context.Context, in *MacFlapPortAction, opts ...grpc.CallOption) (*FdbResult, error) {
+	out := new(FdbResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/SetMacFlapPortAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) GetMoveStats(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (*MacMoveStatsList, error) {
+	out := new(MacMoveStatsList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/GetMoveStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fdbManagementClient) WatchMacFlaps(ctx context.Context, in *FdbFilter, opts ...grpc.CallOption) (FdbManagement_WatchMacFlapsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FdbManagement_serviceDesc.Streams[1], "/OpenNos.Switch.Fdb.FdbManagement/WatchMacFlaps", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fdbManagementWatchMacFlapsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FdbManagement_WatchMacFlapsClient interface {
+	Recv() (*MacFlapEvent, error)
+	grpc.ClientStream
+}
+
+type fdbManagementWatchMacFlapsClient struct {
+	grpc.ClientStream
+}
+
+func (x *fdbManagementWatchMacFlapsClient) Recv() (*MacFlapEvent, error) {
+	m := new(MacFlapEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fdbManagementClient) UnblockMacFlapIface(ctx context.Context, in *MacFlapIface, opts ...grpc.CallOption) (*FdbResult, error) {
+	out := new(FdbResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Fdb.FdbManagement/UnblockMacFlapIface", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FdbManagementServer is the server API for FdbManagement service.
+type FdbManagementServer interface {
+	ListEntries(context.Context, *FdbFilter) (*FdbEntries, error)
+	AddStaticEntry(context.Context, *FdbEntry) (*FdbResult, error)
+	DeleteEntry(context.Context, *FdbEntry) (*FdbResult, error)
+	WatchEvents(*FdbFilter, FdbManagement_WatchEventsServer) error
+	SetMacFlapDetection(context.Context, *MacFlapDetection) (*FdbResult, error)
+	GetMacFlapDetection(context.Context, *FdbEmpty) (*MacFlapDetection, error)
+	SetMacFlapPortAction(context.Context, *MacFlapPortAction) (*FdbResult, error)
+	GetMoveStats(context.Context, *FdbFilter) (*MacMoveStatsList, error)
+	WatchMacFlaps(*FdbFilter, FdbManagement_WatchMacFlapsServer) error
+	// Unblocks interface blocked because of flapping address, restoring its state before the block
+	UnblockMacFlapIface(context.Context, *MacFlapIface) (*FdbResult, error)
+}
+
+// UnimplementedFdbManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedFdbManagementServer struct {
+}
+
+func (*UnimplementedFdbManagementServer) ListEntries(ctx context.Context, req *FdbFilter) (*FdbEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (*UnimplementedFdbManagementServer) AddStaticEntry(ctx context.Context, req *FdbEntry) (*FdbResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStaticEntry not implemented")
+}
+func (*UnimplementedFdbManagementServer) DeleteEntry(ctx context.Context, req *FdbEntry) (*FdbResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+}
+func (*UnimplementedFdbManagementServer) WatchEvents(req *FdbFilter, srv FdbManagement_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (*UnimplementedFdbManagementServer) SetMacFlapDetection(ctx context.Context, req *MacFlapDetection) (*FdbResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMacFlapDetection not implemented")
+}
+func (*UnimplementedFdbManagementServer) GetMacFlapDetection(ctx context.Context, req *FdbEmpty) (*MacFlapDetection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMacFlapDetection not implemented")
+}
+func (*UnimplementedFdbManagementServer) SetMacFlapPortAction(ctx context.Context, req *MacFlapPortAction) (*FdbResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMacFlapPortAction not implemented")
+}
+func (*UnimplementedFdbManagementServer) GetMoveStats(ctx context.Context, req *FdbFilter) (*MacMoveStatsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMoveStats not implemented")
+}
+func (*UnimplementedFdbManagementServer) WatchMacFlaps(req *FdbFilter, srv FdbManagement_WatchMacFlapsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMacFlaps not implemented")
+}
+func (*UnimplementedFdbManagementServer) UnblockMacFlapIface(ctx context.Context, req *MacFlapIface) (*FdbResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockMacFlapIface not implemented")
+}
+
+func RegisterFdbManagementServer(s *grpc.Server, srv FdbManagementServer) {
+	s.RegisterService(&_FdbManagement_serviceDesc, srv)
+}
+
+func _FdbManagement_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FdbFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/ListEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).ListEntries(ctx, req.(*FdbFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_AddStaticEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FdbEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).AddStaticEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/AddStaticEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).AddStaticEntry(ctx, req.(*FdbEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FdbEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).DeleteEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/DeleteEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).DeleteEntry(ctx, req.(*FdbEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FdbFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FdbManagementServer).WatchEvents(m, &fdbManagementWatchEventsServer{stream})
+}
+
+type FdbManagement_WatchEventsServer interface {
+	Send(*FdbEvent) error
+	grpc.ServerStream
+}
+
+type fdbManagementWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fdbManagementWatchEventsServer) Send(m *FdbEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FdbManagement_SetMacFlapDetection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacFlapDetection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).SetMacFlapDetection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/SetMacFlapDetection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).SetMacFlapDetection(ctx, req.(*MacFlapDetection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_GetMacFlapDetection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FdbEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).GetMacFlapDetection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/GetMacFlapDetection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).GetMacFlapDetection(ctx, req.(*FdbEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_SetMacFlapPortAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacFlapPortAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).SetMacFlapPortAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/SetMacFlapPortAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).SetMacFlapPortAction(ctx, req.(*MacFlapPortAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_GetMoveStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FdbFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).GetMoveStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/GetMoveStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).GetMoveStats(ctx, req.(*FdbFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FdbManagement_WatchMacFlaps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FdbFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FdbManagementServer).WatchMacFlaps(m, &fdbManagementWatchMacFlapsServer{stream})
+}
+
+type FdbManagement_WatchMacFlapsServer interface {
+	Send(*MacFlapEvent) error
+	grpc.ServerStream
+}
+
+type fdbManagementWatchMacFlapsServer struct {
+	grpc.ServerStream
+}
+
+func (x *fdbManagementWatchMacFlapsServer) Send(m *MacFlapEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FdbManagement_UnblockMacFlapIface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacFlapIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FdbManagementServer).UnblockMacFlapIface(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Fdb.FdbManagement/UnblockMacFlapIface",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FdbManagementServer).UnblockMacFlapIface(ctx, req.(*MacFlapIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FdbManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.Fdb.FdbManagement",
+	HandlerType: (*FdbManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEntries",
+			Handler:    _FdbManagement_ListEntries_Handler,
+		},
+		{
+			MethodName: "AddStaticEntry",
+			Handler:    _FdbManagement_AddStaticEntry_Handler,
+		},
+		{
+			MethodName: "DeleteEntry",
+			Handler:    _FdbManagement_DeleteEntry_Handler,
+		},
+		{
+			MethodName: "SetMacFlapDetection",
+			Handler:    _FdbManagement_SetMacFlapDetection_Handler,
+		},
+		{
+			MethodName: "GetMacFlapDetection",
+			Handler:    _FdbManagement_GetMacFlapDetection_Handler,
+		},
+		{
+			MethodName: "SetMacFlapPortAction",
+			Handler:    _FdbManagement_SetMacFlapPortAction_Handler,
+		},
+		{
+			MethodName: "GetMoveStats",
+			Handler:    _FdbManagement_GetMoveStats_Handler,
+		},
+		{
+			MethodName: "UnblockMacFlapIface",
+			Handler:    _FdbManagement_UnblockMacFlapIface_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _FdbManagement_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMacFlaps",
+			Handler:       _FdbManagement_WatchMacFlaps_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "fdb_management.proto",
+}
@@ -0,0 +1,643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mirror_management.proto
+
+package OpenNos_Switch_Mirror
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MirrorSource_Direction int32
+
+const (
+	MirrorSource_BOTH    MirrorSource_Direction = 0
+	MirrorSource_INGRESS MirrorSource_Direction = 1
+	MirrorSource_EGRESS  MirrorSource_Direction = 2
+)
+
+var MirrorSource_Direction_name = map[int32]string{
+	0: "BOTH",
+	1: "INGRESS",
+	2: "EGRESS",
+}
+
+var MirrorSource_Direction_value = map[string]int32{
+	"BOTH":    0,
+	"INGRESS": 1,
+	"EGRESS":  2,
+}
+
+func (x MirrorSource_Direction) String() string {
+	return proto.EnumName(MirrorSource_Direction_name, int32(x))
+}
+
+func (MirrorSource_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{0, 0}
+}
+
+type MirrorSession_DestType int32
+
+const (
+	MirrorSession_PORT  MirrorSession_DestType = 0
+	MirrorSession_RSPAN MirrorSession_DestType = 1
+	// Plain GRE encapsulation of mirrored frames, without ERSPAN header
+	MirrorSession_GRE MirrorSession_DestType = 2
+	MirrorSession_CPU MirrorSession_DestType = 3
+)
+
+var MirrorSession_DestType_name = map[int32]string{
+	0: "PORT",
+	1: "RSPAN",
+	2: "GRE",
+	3: "CPU",
+}
+
+var MirrorSession_DestType_value = map[string]int32{
+	"PORT":  0,
+	"RSPAN": 1,
+	"GRE":   2,
+	"CPU":   3,
+}
+
+func (x MirrorSession_DestType) String() string {
+	return proto.EnumName(MirrorSession_DestType_name, int32(x))
+}
+
+func (MirrorSession_DestType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{1, 0}
+}
+
+type MirrorResult_Result int32
+
+const (
+	MirrorResult_FAILED  MirrorResult_Result = 0
+	MirrorResult_SUCCESS MirrorResult_Result = 1
+)
+
+var MirrorResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var MirrorResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x MirrorResult_Result) String() string {
+	return proto.EnumName(MirrorResult_Result_name, int32(x))
+}
+
+func (MirrorResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{5, 0}
+}
+
+type MirrorSource struct {
+	// Name of physical port or LAG
+	Ifname               string                 `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Direction            MirrorSource_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=OpenNos.Switch.Mirror.MirrorSource_Direction" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MirrorSource) Reset()         { *m = MirrorSource{} }
+func (m *MirrorSource) String() string { return proto.CompactTextString(m) }
+func (*MirrorSource) ProtoMessage()    {}
+func (*MirrorSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{0}
+}
+
+func (m *MirrorSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorSource.Unmarshal(m, b)
+}
+func (m *MirrorSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorSource.Marshal(b, m, deterministic)
+}
+func (m *MirrorSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSource.Merge(m, src)
+}
+func (m *MirrorSource) XXX_Size() int {
+	return xxx_messageInfo_MirrorSource.Size(m)
+}
+func (m *MirrorSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorSource proto.InternalMessageInfo
+
+func (m *MirrorSource) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *MirrorSource) GetDirection() MirrorSource_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return MirrorSource_BOTH
+}
+
+type MirrorSession struct {
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sources  []*MirrorSource        `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	DestType MirrorSession_DestType `protobuf:"varint,3,opt,name=destType,proto3,enum=OpenNos.Switch.Mirror.MirrorSession_DestType" json:"destType,omitempty"`
+	// Port which sends mirrored traffic, not used when mirroring to CPU
+	DestIfname string `protobuf:"bytes,4,opt,name=destIfname,proto3" json:"destIfname,omitempty"`
+	// RSPAN VLAN or VLAN of GRE tunnel, zero sends GRE untagged
+	Vlan uint32 `protobuf:"varint,5,opt,name=vlan,proto3" json:"vlan,omitempty"`
+	// Endpoints of GRE tunnel
+	SrcIp  string `protobuf:"bytes,6,opt,name=srcIp,proto3" json:"srcIp,omitempty"`
+	DstIp  string `protobuf:"bytes,7,opt,name=dstIp,proto3" json:"dstIp,omitempty"`
+	SrcMac string `protobuf:"bytes,8,opt,name=srcMac,proto3" json:"srcMac,omitempty"`
+	DstMac string `protobuf:"bytes,9,opt,name=dstMac,proto3" json:"dstMac,omitempty"`
+	Ttl    uint32 `protobuf:"varint,10,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Tos    uint32 `protobuf:"varint,11,opt,name=tos,proto3" json:"tos,omitempty"`
+	// KNET netdev receiving traffic mirrored to CPU
+	Netdev               string   `protobuf:"bytes,12,opt,name=netdev,proto3" json:"netdev,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MirrorSession) Reset()         { *m = MirrorSession{} }
+func (m *MirrorSession) String() string { return proto.CompactTextString(m) }
+func (*MirrorSession) ProtoMessage()    {}
+func (*MirrorSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{1}
+}
+
+func (m *MirrorSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorSession.Unmarshal(m, b)
+}
+func (m *MirrorSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorSession.Marshal(b, m, deterministic)
+}
+func (m *MirrorSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSession.Merge(m, src)
+}
+func (m *MirrorSession) XXX_Size() int {
+	return xxx_messageInfo_MirrorSession.Size(m)
+}
+func (m *MirrorSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorSession proto.InternalMessageInfo
+
+func (m *MirrorSession) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetSources() []*MirrorSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *MirrorSession) GetDestType() MirrorSession_DestType {
+	if m != nil {
+		return m.DestType
+	}
+	return MirrorSession_PORT
+}
+
+func (m *MirrorSession) GetDestIfname() string {
+	if m != nil {
+		return m.DestIfname
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetVlan() uint32 {
+	if m != nil {
+		return m.Vlan
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetSrcIp() string {
+	if m != nil {
+		return m.SrcIp
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetDstIp() string {
+	if m != nil {
+		return m.DstIp
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetSrcMac() string {
+	if m != nil {
+		return m.SrcMac
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetDstMac() string {
+	if m != nil {
+		return m.DstMac
+	}
+	return ""
+}
+
+func (m *MirrorSession) GetTtl() uint32 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetTos() uint32 {
+	if m != nil {
+		return m.Tos
+	}
+	return 0
+}
+
+func (m *MirrorSession) GetNetdev() string {
+	if m != nil {
+		return m.Netdev
+	}
+	return ""
+}
+
+type MirrorSessionName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MirrorSessionName) Reset()         { *m = MirrorSessionName{} }
+func (m *MirrorSessionName) String() string { return proto.CompactTextString(m) }
+func (*MirrorSessionName) ProtoMessage()    {}
+func (*MirrorSessionName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{2}
+}
+
+func (m *MirrorSessionName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorSessionName.Unmarshal(m, b)
+}
+func (m *MirrorSessionName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorSessionName.Marshal(b, m, deterministic)
+}
+func (m *MirrorSessionName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSessionName.Merge(m, src)
+}
+func (m *MirrorSessionName) XXX_Size() int {
+	return xxx_messageInfo_MirrorSessionName.Size(m)
+}
+func (m *MirrorSessionName) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSessionName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorSessionName proto.InternalMessageInfo
+
+func (m *MirrorSessionName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type MirrorSessionList struct {
+	Sessions             []*MirrorSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MirrorSessionList) Reset()         { *m = MirrorSessionList{} }
+func (m *MirrorSessionList) String() string { return proto.CompactTextString(m) }
+func (*MirrorSessionList) ProtoMessage()    {}
+func (*MirrorSessionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{3}
+}
+
+func (m *MirrorSessionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorSessionList.Unmarshal(m, b)
+}
+func (m *MirrorSessionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorSessionList.Marshal(b, m, deterministic)
+}
+func (m *MirrorSessionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSessionList.Merge(m, src)
+}
+func (m *MirrorSessionList) XXX_Size() int {
+	return xxx_messageInfo_MirrorSessionList.Size(m)
+}
+func (m *MirrorSessionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSessionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorSessionList proto.InternalMessageInfo
+
+func (m *MirrorSessionList) GetSessions() []*MirrorSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type MirrorEmpty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MirrorEmpty) Reset()         { *m = MirrorEmpty{} }
+func (m *MirrorEmpty) String() string { return proto.CompactTextString(m) }
+func (*MirrorEmpty) ProtoMessage()    {}
+func (*MirrorEmpty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{4}
+}
+
+func (m *MirrorEmpty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorEmpty.Unmarshal(m, b)
+}
+func (m *MirrorEmpty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorEmpty.Marshal(b, m, deterministic)
+}
+func (m *MirrorEmpty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorEmpty.Merge(m, src)
+}
+func (m *MirrorEmpty) XXX_Size() int {
+	return xxx_messageInfo_MirrorEmpty.Size(m)
+}
+func (m *MirrorEmpty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorEmpty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorEmpty proto.InternalMessageInfo
+
+type MirrorResult struct {
+	Result               MirrorResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.Mirror.MirrorResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MirrorResult) Reset()         { *m = MirrorResult{} }
+func (m *MirrorResult) String() string { return proto.CompactTextString(m) }
+func (*MirrorResult) ProtoMessage()    {}
+func (*MirrorResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa373722e6f0134b, []int{5}
+}
+
+func (m *MirrorResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorResult.Unmarshal(m, b)
+}
+func (m *MirrorResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorResult.Marshal(b, m, deterministic)
+}
+func (m *MirrorResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorResult.Merge(m, src)
+}
+func (m *MirrorResult) XXX_Size() int {
+	return xxx_messageInfo_MirrorResult.Size(m)
+}
+func (m *MirrorResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorResult proto.InternalMessageInfo
+
+func (m *MirrorResult) GetResult() MirrorResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return MirrorResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.Mirror.MirrorSource_Direction", MirrorSource_Direction_name, MirrorSource_Direction_value)
+	proto.RegisterEnum("OpenNos.Switch.Mirror.MirrorSession_DestType", MirrorSession_DestType_name, MirrorSession_DestType_value)
+	proto.RegisterEnum("OpenNos.Switch.Mirror.MirrorResult_Result", MirrorResult_Result_name, MirrorResult_Result_value)
+	proto.RegisterType((*MirrorSource)(nil), "OpenNos.Switch.Mirror.MirrorSource")
+	proto.RegisterType((*MirrorSession)(nil), "OpenNos.Switch.Mirror.MirrorSession")
+	proto.RegisterType((*MirrorSessionName)(nil), "OpenNos.Switch.Mirror.MirrorSessionName")
+	proto.RegisterType((*MirrorSessionList)(nil), "OpenNos.Switch.Mirror.MirrorSessionList")
+	proto.RegisterType((*MirrorEmpty)(nil), "OpenNos.Switch.Mirror.MirrorEmpty")
+	proto.RegisterType((*MirrorResult)(nil), "OpenNos.Switch.Mirror.MirrorResult")
+}
+
+func init() { proto.RegisterFile("mirror_management.proto", fileDescriptor_aa373722e6f0134b) }
+
+var fileDescriptor_aa373722e6f0134b = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xda, 0x4c,
+	0x10, 0xc5, 0x18, 0x0c, 0x0c, 0x21, 0xf2, 0x37, 0x5f, 0x7f, 0x56, 0xb9, 0xa8, 0xe8, 0xa6, 0x52,
+	0x51, 0xa5, 0x5a, 0x2a, 0xbd, 0xae, 0xd4, 0x04, 0x68, 0x6a, 0x35, 0xfc, 0x68, 0x0d, 0xd7, 0x11,
+	0xb5, 0x37, 0xad, 0x25, 0xfc, 0x23, 0xef, 0x92, 0x2a, 0xcf, 0xd3, 0xdb, 0xbe, 0x44, 0xdf, 0xac,
+	0xda, 0x5d, 0x43, 0x21, 0xaa, 0x22, 0x5f, 0x71, 0xe6, 0xb0, 0x67, 0xe6, 0xec, 0x9e, 0x01, 0x78,
+	0x9e, 0xc4, 0x45, 0x91, 0x15, 0x37, 0xc9, 0x3a, 0x5d, 0x7f, 0xe3, 0x09, 0x4f, 0xa5, 0x97, 0x17,
+	0x99, 0xcc, 0xf0, 0xe9, 0x3c, 0xe7, 0xe9, 0x2c, 0x13, 0x5e, 0xf0, 0x23, 0x96, 0xe1, 0x77, 0x6f,
+	0xaa, 0xcf, 0xd1, 0x9f, 0x16, 0x9c, 0x18, 0x18, 0x64, 0xdb, 0x22, 0xe4, 0xf8, 0x0c, 0x9c, 0xf8,
+	0x36, 0x5d, 0x27, 0x9c, 0x58, 0x7d, 0x6b, 0xd0, 0x61, 0x65, 0x85, 0x5f, 0xa0, 0x13, 0xc5, 0x05,
+	0x0f, 0x65, 0x9c, 0xa5, 0xa4, 0xde, 0xb7, 0x06, 0xa7, 0xc3, 0xb7, 0xde, 0x3f, 0x7b, 0x7a, 0x87,
+	0xfd, 0xbc, 0xf1, 0x4e, 0xc4, 0xfe, 0xea, 0xa9, 0x07, 0x9d, 0x3d, 0x8f, 0x6d, 0x68, 0x5c, 0xce,
+	0x97, 0x9f, 0xdd, 0x1a, 0x76, 0xa1, 0xe5, 0xcf, 0xae, 0xd8, 0x24, 0x08, 0x5c, 0x0b, 0x01, 0x9c,
+	0x89, 0xc1, 0x75, 0xfa, 0xcb, 0x86, 0x5e, 0xd9, 0x95, 0x0b, 0xa1, 0x44, 0x08, 0x8d, 0x03, 0x93,
+	0x1a, 0xe3, 0x07, 0x68, 0x09, 0x3d, 0x54, 0x90, 0x7a, 0xdf, 0x1e, 0x74, 0x87, 0xe7, 0x15, 0x0c,
+	0xb2, 0x9d, 0x06, 0x7d, 0x68, 0x47, 0x5c, 0xc8, 0xe5, 0x7d, 0xce, 0x89, 0x5d, 0xe5, 0x82, 0xc6,
+	0x8a, 0x37, 0x2e, 0x45, 0x6c, 0x2f, 0xc7, 0x17, 0x00, 0x0a, 0xfb, 0xe6, 0x21, 0x1b, 0xda, 0xe3,
+	0x01, 0xa3, 0xdc, 0xdf, 0x6d, 0xd6, 0x29, 0x69, 0xf6, 0xad, 0x41, 0x8f, 0x69, 0x8c, 0x4f, 0xa0,
+	0x29, 0x8a, 0xd0, 0xcf, 0x89, 0xa3, 0x8f, 0x9b, 0x42, 0xb1, 0x91, 0x90, 0x7e, 0x4e, 0x5a, 0x86,
+	0xd5, 0x85, 0x0a, 0x49, 0x14, 0xe1, 0x74, 0x1d, 0x92, 0xb6, 0x09, 0xc9, 0x54, 0x8a, 0x8f, 0x84,
+	0x54, 0x7c, 0xc7, 0xf0, 0xa6, 0x42, 0x17, 0x6c, 0x29, 0x37, 0x04, 0xf4, 0x38, 0x05, 0x35, 0x93,
+	0x09, 0xd2, 0x2d, 0x99, 0x4c, 0x28, 0x6d, 0xca, 0x65, 0xc4, 0xef, 0xc8, 0x89, 0xd1, 0x9a, 0x8a,
+	0xbe, 0x83, 0xf6, 0xee, 0x86, 0x2a, 0xaa, 0xc5, 0x9c, 0x2d, 0xdd, 0x1a, 0x76, 0xa0, 0xc9, 0x82,
+	0xc5, 0xc5, 0xcc, 0xb5, 0xb0, 0x05, 0xf6, 0x15, 0x9b, 0xb8, 0x75, 0x05, 0x46, 0x8b, 0x95, 0x6b,
+	0xd3, 0xd7, 0xf0, 0xdf, 0xd1, 0x13, 0xcd, 0xca, 0x3b, 0x3f, 0x4c, 0x8c, 0xae, 0x1e, 0x1c, 0xbc,
+	0x8e, 0x85, 0xc4, 0x8f, 0xd0, 0x16, 0xa6, 0x14, 0xc4, 0xd2, 0x39, 0xbe, 0xaa, 0x92, 0x03, 0xdb,
+	0xab, 0x68, 0x0f, 0xba, 0xe6, 0xab, 0x49, 0x92, 0xcb, 0x7b, 0xba, 0xdd, 0xad, 0x38, 0xe3, 0x62,
+	0xbb, 0x91, 0x78, 0x09, 0x4e, 0xa1, 0x91, 0xf6, 0x72, 0x3a, 0x7c, 0xf3, 0x68, 0x7b, 0x23, 0xf2,
+	0xcc, 0x07, 0x2b, 0x95, 0xf4, 0x25, 0x38, 0x65, 0x37, 0x00, 0xe7, 0xd3, 0x85, 0x7f, 0x3d, 0x19,
+	0x9b, 0x05, 0x0e, 0x56, 0xa3, 0x91, 0x5e, 0xe0, 0xe1, 0xef, 0x3a, 0xb8, 0xa6, 0xc5, 0x74, 0xff,
+	0x63, 0xc4, 0x1b, 0x70, 0x03, 0x2e, 0x8f, 0x77, 0xb9, 0xd2, 0xf5, 0xce, 0xce, 0x2b, 0xb8, 0xa4,
+	0x35, 0xbc, 0x85, 0xff, 0xc7, 0x7c, 0xc3, 0x25, 0x3f, 0x9e, 0x31, 0xa8, 0x32, 0x43, 0xe5, 0x54,
+	0x75, 0x4e, 0x04, 0xa8, 0xd2, 0x3a, 0xd2, 0x0b, 0xa4, 0x8f, 0x8a, 0x75, 0x1c, 0x67, 0x95, 0xac,
+	0xa8, 0xde, 0xb4, 0xf6, 0xd5, 0xd1, 0x7f, 0x5e, 0xef, 0xff, 0x0c, 0x00, 0xd5, 0x43, 0x6b, 0xc2,
+	0xd7, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MirrorManagementClient is the client API for MirrorManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MirrorManagementClient interface {
+	// Creates session or replaces session of the same name
+	SetMirrorSession(ctx context.Context, in *MirrorSession, opts ...grpc.CallOption) (*MirrorResult, error)
+	DeleteMirrorSession(ctx context.Context, in *MirrorSessionName, opts ...grpc.CallOption) (*MirrorResult, error)
+	ListMirrorSessions(ctx context.Context, in *MirrorEmpty, opts ...grpc.CallOption) (*MirrorSessionList, error)
+}
+
+type mirrorManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMirrorManagementClient(cc *grpc.ClientConn) MirrorManagementClient {
+	return &mirrorManagementClient{cc}
+}
+
+func (c *mirrorManagementClient) SetMirrorSession(ctx context.Context, in *MirrorSession, opts ...grpc.CallOption) (*MirrorResult, error) {
+	out := new(MirrorResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Mirror.MirrorManagement/SetMirrorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mirrorManagementClient) DeleteMirrorSession(ctx context.Context, in *MirrorSessionName, opts ...grpc.CallOption) (*MirrorResult, error) {
+	out := new(MirrorResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Mirror.MirrorManagement/DeleteMirrorSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mirrorManagementClient) ListMirrorSessions(ctx context.Context, in *MirrorEmpty, opts ...grpc.CallOption) (*MirrorSessionList, error) {
+	out := new(MirrorSessionList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Mirror.MirrorManagement/ListMirrorSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MirrorManagementServer is the server API for MirrorManagement service.
+type MirrorManagementServer interface {
+	// Creates session or replaces session of the same name
+	SetMirrorSession(context.Context, *MirrorSession) (*MirrorResult, error)
+	DeleteMirrorSession(context.Context, *MirrorSessionName) (*MirrorResult, error)
+	ListMirrorSessions(context.Context, *MirrorEmpty) (*MirrorSessionList, error)
+}
+
+// UnimplementedMirrorManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedMirrorManagementServer struct {
+}
+
+func (*UnimplementedMirrorManagementServer) SetMirrorSession(ctx context.Context, req *MirrorSession) (*MirrorResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMirrorSession not implemented")
+}
+func (*UnimplementedMirrorManagementServer) DeleteMirrorSession(ctx context.Context, req *MirrorSessionName) (*MirrorResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMirrorSession not implemented")
+}
+func (*UnimplementedMirrorManagementServer) ListMirrorSessions(ctx context.Context, req *MirrorEmpty) (*MirrorSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMirrorSessions not implemented")
+}
+
+func RegisterMirrorManagementServer(s *grpc.Server, srv MirrorManagementServer) {
+	s.RegisterService(&_MirrorManagement_serviceDesc, srv)
+}
+
+func _MirrorManagement_SetMirrorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MirrorSession)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MirrorManagementServer).SetMirrorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Mirror.MirrorManagement/SetMirrorSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MirrorManagementServer).SetMirrorSession(ctx, req.(*MirrorSession))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MirrorManagement_DeleteMirrorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MirrorSessionName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MirrorManagementServer).DeleteMirrorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Mirror.MirrorManagement/DeleteMirrorSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MirrorManagementServer).DeleteMirrorSession(ctx, req.(*MirrorSessionName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MirrorManagement_ListMirrorSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MirrorEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MirrorManagementServer).ListMirrorSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Mirror.MirrorManagement/ListMirrorSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MirrorManagementServer).ListMirrorSessions(ctx, req.(*MirrorEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MirrorManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.Mirror.MirrorManagement",
+	HandlerType: (*MirrorManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetMirrorSession",
+			Handler:    _MirrorManagement_SetMirrorSession_Handler,
+		},
+		{
+			MethodName: "DeleteMirrorSession",
+			Handler:    _MirrorManagement_DeleteMirrorSession_Handler,
+		},
+		{
+			MethodName: "ListMirrorSessions",
+			Handler:    _MirrorManagement_ListMirrorSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mirror_management.proto",
+}
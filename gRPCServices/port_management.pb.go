@@ -0,0 +1,2506 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: port_management.proto
+
+package OpenNos_Switch_Port
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PortSpeed_Duplex int32
+
+const (
+	PortSpeed_FULL PortSpeed_Duplex = 0
+	PortSpeed_HALF PortSpeed_Duplex = 1
+)
+
+var PortSpeed_Duplex_name = map[int32]string{
+	0: "FULL",
+	1: "HALF",
+}
+
+var PortSpeed_Duplex_value = map[string]int32{
+	"FULL": 0,
+	"HALF": 1,
+}
+
+func (x PortSpeed_Duplex) String() string {
+	return proto.EnumName(PortSpeed_Duplex_name, int32(x))
+}
+
+func (PortSpeed_Duplex) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{2, 0}
+}
+
+type PortFec_Mode int32
+
+const (
+	PortFec_OFF  PortFec_Mode = 0
+	PortFec_ON   PortFec_Mode = 1
+	PortFec_AUTO PortFec_Mode = 2
+)
+
+var PortFec_Mode_name = map[int32]string{
+	0: "OFF",
+	1: "ON",
+	2: "AUTO",
+}
+
+var PortFec_Mode_value = map[string]int32{
+	"OFF":  0,
+	"ON":   1,
+	"AUTO": 2,
+}
+
+func (x PortFec_Mode) String() string {
+	return proto.EnumName(PortFec_Mode_name, int32(x))
+}
+
+func (PortFec_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{3, 0}
+}
+
+type PortStatus_OperStatus int32
+
+const (
+	PortStatus_DOWN         PortStatus_OperStatus = 0
+	PortStatus_UP           PortStatus_OperStatus = 1
+	PortStatus_ADMIN_DOWN   PortStatus_OperStatus = 2
+	PortStatus_ERR_DISABLED PortStatus_OperStatus = 3
+)
+
+var PortStatus_OperStatus_name = map[int32]string{
+	0: "DOWN",
+	1: "UP",
+	2: "ADMIN_DOWN",
+	3: "ERR_DISABLED",
+}
+
+var PortStatus_OperStatus_value = map[string]int32{
+	"DOWN":         0,
+	"UP":           1,
+	"ADMIN_DOWN":   2,
+	"ERR_DISABLED": 3,
+}
+
+func (x PortStatus_OperStatus) String() string {
+	return proto.EnumName(PortStatus_OperStatus_name, int32(x))
+}
+
+func (PortStatus_OperStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{9, 0}
+}
+
+type PortStormControl_Unit int32
+
+const (
+	PortStormControl_PPS     PortStormControl_Unit = 0
+	PortStormControl_PERCENT PortStormControl_Unit = 1
+)
+
+var PortStormControl_Unit_name = map[int32]string{
+	0: "PPS",
+	1: "PERCENT",
+}
+
+var PortStormControl_Unit_value = map[string]int32{
+	"PPS":     0,
+	"PERCENT": 1,
+}
+
+func (x PortStormControl_Unit) String() string {
+	return proto.EnumName(PortStormControl_Unit_name, int32(x))
+}
+
+func (PortStormControl_Unit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{18, 0}
+}
+
+type PortStormControl_Action int32
+
+const (
+	PortStormControl_DROP        PortStormControl_Action = 0
+	PortStormControl_ERR_DISABLE PortStormControl_Action = 1
+)
+
+var PortStormControl_Action_name = map[int32]string{
+	0: "DROP",
+	1: "ERR_DISABLE",
+}
+
+var PortStormControl_Action_value = map[string]int32{
+	"DROP":        0,
+	"ERR_DISABLE": 1,
+}
+
+func (x PortStormControl_Action) String() string {
+	return proto.EnumName(PortStormControl_Action_name, int32(x))
+}
+
+func (PortStormControl_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{18, 1}
+}
+
+type PortSelfTest_Loopback int32
+
+const (
+	PortSelfTest_MAC PortSelfTest_Loopback = 0
+	PortSelfTest_PHY PortSelfTest_Loopback = 1
+)
+
+var PortSelfTest_Loopback_name = map[int32]string{
+	0: "MAC",
+	1: "PHY",
+}
+
+var PortSelfTest_Loopback_value = map[string]int32{
+	"MAC": 0,
+	"PHY": 1,
+}
+
+func (x PortSelfTest_Loopback) String() string {
+	return proto.EnumName(PortSelfTest_Loopback_name, int32(x))
+}
+
+func (PortSelfTest_Loopback) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{21, 0}
+}
+
+type PortResult_Result int32
+
+const (
+	PortResult_FAILED  PortResult_Result = 0
+	PortResult_SUCCESS PortResult_Result = 1
+)
+
+var PortResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var PortResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x PortResult_Result) String() string {
+	return proto.EnumName(PortResult_Result_name, int32(x))
+}
+
+func (PortResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{23, 0}
+}
+
+type PortIface struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortIface) Reset()         { *m = PortIface{} }
+func (m *PortIface) String() string { return proto.CompactTextString(m) }
+func (*PortIface) ProtoMessage()    {}
+func (*PortIface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{0}
+}
+
+func (m *PortIface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortIface.Unmarshal(m, b)
+}
+func (m *PortIface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortIface.Marshal(b, m, deterministic)
+}
+func (m *PortIface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortIface.Merge(m, src)
+}
+func (m *PortIface) XXX_Size() int {
+	return xxx_messageInfo_PortIface.Size(m)
+}
+func (m *PortIface) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortIface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortIface proto.InternalMessageInfo
+
+func (m *PortIface) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+type PortAdminState struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortAdminState) Reset()         { *m = PortAdminState{} }
+func (m *PortAdminState) String() string { return proto.CompactTextString(m) }
+func (*PortAdminState) ProtoMessage()    {}
+func (*PortAdminState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{1}
+}
+
+func (m *PortAdminState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortAdminState.Unmarshal(m, b)
+}
+func (m *PortAdminState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortAdminState.Marshal(b, m, deterministic)
+}
+func (m *PortAdminState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortAdminState.Merge(m, src)
+}
+func (m *PortAdminState) XXX_Size() int {
+	return xxx_messageInfo_PortAdminState.Size(m)
+}
+func (m *PortAdminState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortAdminState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortAdminState proto.InternalMessageInfo
+
+func (m *PortAdminState) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortAdminState) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type PortSpeed struct {
+	Ifname string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	// Speed in Mb/s
+	Speed                uint32           `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	Duplex               PortSpeed_Duplex `protobuf:"varint,3,opt,name=duplex,proto3,enum=OpenNos.Switch.Port.PortSpeed_Duplex" json:"duplex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PortSpeed) Reset()         { *m = PortSpeed{} }
+func (m *PortSpeed) String() string { return proto.CompactTextString(m) }
+func (*PortSpeed) ProtoMessage()    {}
+func (*PortSpeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{2}
+}
+
+func (m *PortSpeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSpeed.Unmarshal(m, b)
+}
+func (m *PortSpeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSpeed.Marshal(b, m, deterministic)
+}
+func (m *PortSpeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSpeed.Merge(m, src)
+}
+func (m *PortSpeed) XXX_Size() int {
+	return xxx_messageInfo_PortSpeed.Size(m)
+}
+func (m *PortSpeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSpeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSpeed proto.InternalMessageInfo
+
+func (m *PortSpeed) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortSpeed) GetSpeed() uint32 {
+	if m != nil {
+		return m.Speed
+	}
+	return 0
+}
+
+func (m *PortSpeed) GetDuplex() PortSpeed_Duplex {
+	if m != nil {
+		return m.Duplex
+	}
+	return PortSpeed_FULL
+}
+
+type PortFec struct {
+	Ifname               string       `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Mode                 PortFec_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=OpenNos.Switch.Port.PortFec_Mode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PortFec) Reset()         { *m = PortFec{} }
+func (m *PortFec) String() string { return proto.CompactTextString(m) }
+func (*PortFec) ProtoMessage()    {}
+func (*PortFec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{3}
+}
+
+func (m *PortFec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortFec.Unmarshal(m, b)
+}
+func (m *PortFec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortFec.Marshal(b, m, deterministic)
+}
+func (m *PortFec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortFec.Merge(m, src)
+}
+func (m *PortFec) XXX_Size() int {
+	return xxx_messageInfo_PortFec.Size(m)
+}
+func (m *PortFec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortFec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortFec proto.InternalMessageInfo
+
+func (m *PortFec) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortFec) GetMode() PortFec_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return PortFec_OFF
+}
+
+type PortAutoneg struct {
+	Ifname  string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Speeds in Mb/s, empty list advertises all speeds supported by port
+	AdvertisedSpeeds     []uint32 `protobuf:"varint,3,rep,packed,name=advertisedSpeeds,proto3" json:"advertisedSpeeds,omitempty"`
+	AdvertisePause       bool     `protobuf:"varint,4,opt,name=advertisePause,proto3" json:"advertisePause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortAutoneg) Reset()         { *m = PortAutoneg{} }
+func (m *PortAutoneg) String() string { return proto.CompactTextString(m) }
+func (*PortAutoneg) ProtoMessage()    {}
+func (*PortAutoneg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{4}
+}
+
+func (m *PortAutoneg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortAutoneg.Unmarshal(m, b)
+}
+func (m *PortAutoneg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortAutoneg.Marshal(b, m, deterministic)
+}
+func (m *PortAutoneg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortAutoneg.Merge(m, src)
+}
+func (m *PortAutoneg) XXX_Size() int {
+	return xxx_messageInfo_PortAutoneg.Size(m)
+}
+func (m *PortAutoneg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortAutoneg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortAutoneg proto.InternalMessageInfo
+
+func (m *PortAutoneg) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortAutoneg) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PortAutoneg) GetAdvertisedSpeeds() []uint32 {
+	if m != nil {
+		return m.AdvertisedSpeeds
+	}
+	return nil
+}
+
+func (m *PortAutoneg) GetAdvertisePause() bool {
+	if m != nil {
+		return m.AdvertisePause
+	}
+	return false
+}
+
+type PortMtu struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Mtu                  uint32   `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortMtu) Reset()         { *m = PortMtu{} }
+func (m *PortMtu) String() string { return proto.CompactTextString(m) }
+func (*PortMtu) ProtoMessage()    {}
+func (*PortMtu) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{5}
+}
+
+func (m *PortMtu) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortMtu.Unmarshal(m, b)
+}
+func (m *PortMtu) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortMtu.Marshal(b, m, deterministic)
+}
+func (m *PortMtu) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortMtu.Merge(m, src)
+}
+func (m *PortMtu) XXX_Size() int {
+	return xxx_messageInfo_PortMtu.Size(m)
+}
+func (m *PortMtu) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortMtu.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortMtu proto.InternalMessageInfo
+
+func (m *PortMtu) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortMtu) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+type PortFlowControl struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Tx                   bool     `protobuf:"varint,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Rx                   bool     `protobuf:"varint,3,opt,name=rx,proto3" json:"rx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortFlowControl) Reset()         { *m = PortFlowControl{} }
+func (m *PortFlowControl) String() string { return proto.CompactTextString(m) }
+func (*PortFlowControl) ProtoMessage()    {}
+func (*PortFlowControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{6}
+}
+
+func (m *PortFlowControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortFlowControl.Unmarshal(m, b)
+}
+func (m *PortFlowControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortFlowControl.Marshal(b, m, deterministic)
+}
+func (m *PortFlowControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortFlowControl.Merge(m, src)
+}
+func (m *PortFlowControl) XXX_Size() int {
+	return xxx_messageInfo_PortFlowControl.Size(m)
+}
+func (m *PortFlowControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortFlowControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortFlowControl proto.InternalMessageInfo
+
+func (m *PortFlowControl) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortFlowControl) GetTx() bool {
+	if m != nil {
+		return m.Tx
+	}
+	return false
+}
+
+func (m *PortFlowControl) GetRx() bool {
+	if m != nil {
+		return m.Rx
+	}
+	return false
+}
+
+type PortConfig struct {
+	Ifname      string           `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Enabled     bool             `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Speed       *PortSpeed       `protobuf:"bytes,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Fec         *PortFec         `protobuf:"bytes,4,opt,name=fec,proto3" json:"fec,omitempty"`
+	Autoneg     *PortAutoneg     `protobuf:"bytes,5,opt,name=autoneg,proto3" json:"autoneg,omitempty"`
+	Mtu         *PortMtu         `protobuf:"bytes,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	FlowControl *PortFlowControl `protobuf:"bytes,7,opt,name=flowControl,proto3" json:"flowControl,omitempty"`
+	// Full duplex speeds in Mb/s supported by port
+	SupportedSpeeds      []uint32 `protobuf:"varint,8,rep,packed,name=supportedSpeeds,proto3" json:"supportedSpeeds,omitempty"`
+	ErrDisabled          bool     `protobuf:"varint,9,opt,name=errDisabled,proto3" json:"errDisabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortConfig) Reset()         { *m = PortConfig{} }
+func (m *PortConfig) String() string { return proto.CompactTextString(m) }
+func (*PortConfig) ProtoMessage()    {}
+func (*PortConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{7}
+}
+
+func (m *PortConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortConfig.Unmarshal(m, b)
+}
+func (m *PortConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortConfig.Marshal(b, m, deterministic)
+}
+func (m *PortConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortConfig.Merge(m, src)
+}
+func (m *PortConfig) XXX_Size() int {
+	return xxx_messageInfo_PortConfig.Size(m)
+}
+func (m *PortConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortConfig proto.InternalMessageInfo
+
+func (m *PortConfig) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PortConfig) GetSpeed() *PortSpeed {
+	if m != nil {
+		return m.Speed
+	}
+	return nil
+}
+
+func (m *PortConfig) GetFec() *PortFec {
+	if m != nil {
+		return m.Fec
+	}
+	return nil
+}
+
+func (m *PortConfig) GetAutoneg() *PortAutoneg {
+	if m != nil {
+		return m.Autoneg
+	}
+	return nil
+}
+
+func (m *PortConfig) GetMtu() *PortMtu {
+	if m != nil {
+		return m.Mtu
+	}
+	return nil
+}
+
+func (m *PortConfig) GetFlowControl() *PortFlowControl {
+	if m != nil {
+		return m.FlowControl
+	}
+	return nil
+}
+
+func (m *PortConfig) GetSupportedSpeeds() []uint32 {
+	if m != nil {
+		return m.SupportedSpeeds
+	}
+	return nil
+}
+
+func (m *PortConfig) GetErrDisabled() bool {
+	if m != nil {
+		return m.ErrDisabled
+	}
+	return false
+}
+
+type PortConfigList struct {
+	Configs              []*PortConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PortConfigList) Reset()         { *m = PortConfigList{} }
+func (m *PortConfigList) String() string { return proto.CompactTextString(m) }
+func (*PortConfigList) ProtoMessage()    {}
+func (*PortConfigList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{8}
+}
+
+func (m *PortConfigList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortConfigList.Unmarshal(m, b)
+}
+func (m *PortConfigList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortConfigList.Marshal(b, m, deterministic)
+}
+func (m *PortConfigList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortConfigList.Merge(m, src)
+}
+func (m *PortConfigList) XXX_Size() int {
+	return xxx_messageInfo_PortConfigList.Size(m)
+}
+func (m *PortConfigList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortConfigList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortConfigList proto.InternalMessageInfo
+
+func (m *PortConfigList) GetConfigs() []*PortConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+type PortStatus struct {
+	Ifname     string                `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	OperStatus PortStatus_OperStatus `protobuf:"varint,2,opt,name=operStatus,proto3,enum=OpenNos.Switch.Port.PortStatus_OperStatus" json:"operStatus,omitempty"`
+	// Speed, duplex and FEC currently used by port
+	Speed *PortSpeed `protobuf:"bytes,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Fec   *PortFec   `protobuf:"bytes,4,opt,name=fec,proto3" json:"fec,omitempty"`
+	// Unix time when link came up, zero while link is down
+	LinkUpTime int64  `protobuf:"varint,5,opt,name=linkUpTime,proto3" json:"linkUpTime,omitempty"`
+	Flaps      uint64 `protobuf:"varint,6,opt,name=flaps,proto3" json:"flaps,omitempty"`
+	Port       int32  `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	Gport      int32  `protobuf:"varint,8,opt,name=gport,proto3" json:"gport,omitempty"`
+	// Index of port netdev in kernel
+	Ifindex              int32    `protobuf:"varint,9,opt,name=ifindex,proto3" json:"ifindex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortStatus) Reset()         { *m = PortStatus{} }
+func (m *PortStatus) String() string { return proto.CompactTextString(m) }
+func (*PortStatus) ProtoMessage()    {}
+func (*PortStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{9}
+}
+
+func (m *PortStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStatus.Unmarshal(m, b)
+}
+func (m *PortStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStatus.Marshal(b, m, deterministic)
+}
+func (m *PortStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStatus.Merge(m, src)
+}
+func (m *PortStatus) XXX_Size() int {
+	return xxx_messageInfo_PortStatus.Size(m)
+}
+func (m *PortStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStatus proto.InternalMessageInfo
+
+func (m *PortStatus) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortStatus) GetOperStatus() PortStatus_OperStatus {
+	if m != nil {
+		return m.OperStatus
+	}
+	return PortStatus_DOWN
+}
+
+func (m *PortStatus) GetSpeed() *PortSpeed {
+	if m != nil {
+		return m.Speed
+	}
+	return nil
+}
+
+func (m *PortStatus) GetFec() *PortFec {
+	if m != nil {
+		return m.Fec
+	}
+	return nil
+}
+
+func (m *PortStatus) GetLinkUpTime() int64 {
+	if m != nil {
+		return m.LinkUpTime
+	}
+	return 0
+}
+
+func (m *PortStatus) GetFlaps() uint64 {
+	if m != nil {
+		return m.Flaps
+	}
+	return 0
+}
+
+func (m *PortStatus) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PortStatus) GetGport() int32 {
+	if m != nil {
+		return m.Gport
+	}
+	return 0
+}
+
+func (m *PortStatus) GetIfindex() int32 {
+	if m != nil {
+		return m.Ifindex
+	}
+	return 0
+}
+
+type PortStatusList struct {
+	Statuses             []*PortStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PortStatusList) Reset()         { *m = PortStatusList{} }
+func (m *PortStatusList) String() string { return proto.CompactTextString(m) }
+func (*PortStatusList) ProtoMessage()    {}
+func (*PortStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{10}
+}
+
+func (m *PortStatusList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStatusList.Unmarshal(m, b)
+}
+func (m *PortStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStatusList.Marshal(b, m, deterministic)
+}
+func (m *PortStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStatusList.Merge(m, src)
+}
+func (m *PortStatusList) XXX_Size() int {
+	return xxx_messageInfo_PortStatusList.Size(m)
+}
+func (m *PortStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStatusList proto.InternalMessageInfo
+
+func (m *PortStatusList) GetStatuses() []*PortStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type PortLinkEvent struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	LinkUp               bool     `protobuf:"varint,2,opt,name=linkUp,proto3" json:"linkUp,omitempty"`
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortLinkEvent) Reset()         { *m = PortLinkEvent{} }
+func (m *PortLinkEvent) String() string { return proto.CompactTextString(m) }
+func (*PortLinkEvent) ProtoMessage()    {}
+func (*PortLinkEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{11}
+}
+
+func (m *PortLinkEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortLinkEvent.Unmarshal(m, b)
+}
+func (m *PortLinkEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortLinkEvent.Marshal(b, m, deterministic)
+}
+func (m *PortLinkEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortLinkEvent.Merge(m, src)
+}
+func (m *PortLinkEvent) XXX_Size() int {
+	return xxx_messageInfo_PortLinkEvent.Size(m)
+}
+func (m *PortLinkEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortLinkEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortLinkEvent proto.InternalMessageInfo
+
+func (m *PortLinkEvent) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortLinkEvent) GetLinkUp() bool {
+	if m != nil {
+		return m.LinkUp
+	}
+	return false
+}
+
+func (m *PortLinkEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type PortCounters struct {
+	InOctets         uint64 `protobuf:"varint,1,opt,name=inOctets,proto3" json:"inOctets,omitempty"`
+	InUcastPkts      uint64 `protobuf:"varint,2,opt,name=inUcastPkts,proto3" json:"inUcastPkts,omitempty"`
+	InMulticastPkts  uint64 `protobuf:"varint,3,opt,name=inMulticastPkts,proto3" json:"inMulticastPkts,omitempty"`
+	InBroadcastPkts  uint64 `protobuf:"varint,4,opt,name=inBroadcastPkts,proto3" json:"inBroadcastPkts,omitempty"`
+	InDiscards       uint64 `protobuf:"varint,5,opt,name=inDiscards,proto3" json:"inDiscards,omitempty"`
+	InErrors         uint64 `protobuf:"varint,6,opt,name=inErrors,proto3" json:"inErrors,omitempty"`
+	InFcsErrors      uint64 `protobuf:"varint,7,opt,name=inFcsErrors,proto3" json:"inFcsErrors,omitempty"`
+	OutOctets        uint64 `protobuf:"varint,8,opt,name=outOctets,proto3" json:"outOctets,omitempty"`
+	OutUcastPkts     uint64 `protobuf:"varint,9,opt,name=outUcastPkts,proto3" json:"outUcastPkts,omitempty"`
+	OutMulticastPkts uint64 `protobuf:"varint,10,opt,name=outMulticastPkts,proto3" json:"outMulticastPkts,omitempty"`
+	OutBroadcastPkts uint64 `protobuf:"varint,11,opt,name=outBroadcastPkts,proto3" json:"outBroadcastPkts,omitempty"`
+	OutDiscards      uint64 `protobuf:"varint,12,opt,name=outDiscards,proto3" json:"outDiscards,omitempty"`
+	OutErrors        uint64 `protobuf:"varint,13,opt,name=outErrors,proto3" json:"outErrors,omitempty"`
+	// Received and transmitted packets by size in octets
+	Pkts64               uint64   `protobuf:"varint,14,opt,name=pkts64,proto3" json:"pkts64,omitempty"`
+	Pkts65To127          uint64   `protobuf:"varint,15,opt,name=pkts65to127,proto3" json:"pkts65to127,omitempty"`
+	Pkts128To255         uint64   `protobuf:"varint,16,opt,name=pkts128to255,proto3" json:"pkts128to255,omitempty"`
+	Pkts256To511         uint64   `protobuf:"varint,17,opt,name=pkts256to511,proto3" json:"pkts256to511,omitempty"`
+	Pkts512To1023        uint64   `protobuf:"varint,18,opt,name=pkts512to1023,proto3" json:"pkts512to1023,omitempty"`
+	Pkts1024To1518       uint64   `protobuf:"varint,19,opt,name=pkts1024to1518,proto3" json:"pkts1024to1518,omitempty"`
+	Pkts1519ToMax        uint64   `protobuf:"varint,20,opt,name=pkts1519toMax,proto3" json:"pkts1519toMax,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortCounters) Reset()         { *m = PortCounters{} }
+func (m *PortCounters) String() string { return proto.CompactTextString(m) }
+func (*PortCounters) ProtoMessage()    {}
+func (*PortCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{12}
+}
+
+func (m *PortCounters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortCounters.Unmarshal(m, b)
+}
+func (m *PortCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortCounters.Marshal(b, m, deterministic)
+}
+func (m *PortCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortCounters.Merge(m, src)
+}
+func (m *PortCounters) XXX_Size() int {
+	return xxx_messageInfo_PortCounters.Size(m)
+}
+func (m *PortCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortCounters proto.InternalMessageInfo
+
+func (m *PortCounters) GetInOctets() uint64 {
+	if m != nil {
+		return m.InOctets
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInUcastPkts() uint64 {
+	if m != nil {
+		return m.InUcastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInMulticastPkts() uint64 {
+	if m != nil {
+		return m.InMulticastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInBroadcastPkts() uint64 {
+	if m != nil {
+		return m.InBroadcastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInDiscards() uint64 {
+	if m != nil {
+		return m.InDiscards
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInErrors() uint64 {
+	if m != nil {
+		return m.InErrors
+	}
+	return 0
+}
+
+func (m *PortCounters) GetInFcsErrors() uint64 {
+	if m != nil {
+		return m.InFcsErrors
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutOctets() uint64 {
+	if m != nil {
+		return m.OutOctets
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutUcastPkts() uint64 {
+	if m != nil {
+		return m.OutUcastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutMulticastPkts() uint64 {
+	if m != nil {
+		return m.OutMulticastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutBroadcastPkts() uint64 {
+	if m != nil {
+		return m.OutBroadcastPkts
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutDiscards() uint64 {
+	if m != nil {
+		return m.OutDiscards
+	}
+	return 0
+}
+
+func (m *PortCounters) GetOutErrors() uint64 {
+	if m != nil {
+		return m.OutErrors
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts64() uint64 {
+	if m != nil {
+		return m.Pkts64
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts65To127() uint64 {
+	if m != nil {
+		return m.Pkts65To127
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts128To255() uint64 {
+	if m != nil {
+		return m.Pkts128To255
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts256To511() uint64 {
+	if m != nil {
+		return m.Pkts256To511
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts512To1023() uint64 {
+	if m != nil {
+		return m.Pkts512To1023
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts1024To1518() uint64 {
+	if m != nil {
+		return m.Pkts1024To1518
+	}
+	return 0
+}
+
+func (m *PortCounters) GetPkts1519ToMax() uint64 {
+	if m != nil {
+		return m.Pkts1519ToMax
+	}
+	return 0
+}
+
+type PortRates struct {
+	InBps                uint64   `protobuf:"varint,1,opt,name=inBps,proto3" json:"inBps,omitempty"`
+	OutBps               uint64   `protobuf:"varint,2,opt,name=outBps,proto3" json:"outBps,omitempty"`
+	InPps                uint64   `protobuf:"varint,3,opt,name=inPps,proto3" json:"inPps,omitempty"`
+	OutPps               uint64   `protobuf:"varint,4,opt,name=outPps,proto3" json:"outPps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortRates) Reset()         { *m = PortRates{} }
+func (m *PortRates) String() string { return proto.CompactTextString(m) }
+func (*PortRates) ProtoMessage()    {}
+func (*PortRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{13}
+}
+
+func (m *PortRates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortRates.Unmarshal(m, b)
+}
+func (m *PortRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortRates.Marshal(b, m, deterministic)
+}
+func (m *PortRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortRates.Merge(m, src)
+}
+func (m *PortRates) XXX_Size() int {
+	return xxx_messageInfo_PortRates.Size(m)
+}
+func (m *PortRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortRates proto.InternalMessageInfo
+
+func (m *PortRates) GetInBps() uint64 {
+	if m != nil {
+		return m.InBps
+	}
+	return 0
+}
+
+func (m *PortRates) GetOutBps() uint64 {
+	if m != nil {
+		return m.OutBps
+	}
+	return 0
+}
+
+func (m *PortRates) GetInPps() uint64 {
+	if m != nil {
+		return m.InPps
+	}
+	return 0
+}
+
+func (m *PortRates) GetOutPps() uint64 {
+	if m != nil {
+		return m.OutPps
+	}
+	return 0
+}
+
+type PortStatsSample struct {
+	Timestamp int64         `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Counters  *PortCounters `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters,omitempty"`
+	// Rates since previous sample
+	Rates                *PortRates `protobuf:"bytes,3,opt,name=rates,proto3" json:"rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PortStatsSample) Reset()         { *m = PortStatsSample{} }
+func (m *PortStatsSample) String() string { return proto.CompactTextString(m) }
+func (*PortStatsSample) ProtoMessage()    {}
+func (*PortStatsSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{14}
+}
+
+func (m *PortStatsSample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStatsSample.Unmarshal(m, b)
+}
+func (m *PortStatsSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStatsSample.Marshal(b, m, deterministic)
+}
+func (m *PortStatsSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStatsSample.Merge(m, src)
+}
+func (m *PortStatsSample) XXX_Size() int {
+	return xxx_messageInfo_PortStatsSample.Size(m)
+}
+func (m *PortStatsSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStatsSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStatsSample proto.InternalMessageInfo
+
+func (m *PortStatsSample) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PortStatsSample) GetCounters() *PortCounters {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func (m *PortStatsSample) GetRates() *PortRates {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type PortStatsRequest struct {
+	// Empty interface name returns statistics of all ports
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	History              bool     `protobuf:"varint,2,opt,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortStatsRequest) Reset()         { *m = PortStatsRequest{} }
+func (m *PortStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*PortStatsRequest) ProtoMessage()    {}
+func (*PortStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{15}
+}
+
+func (m *PortStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStatsRequest.Unmarshal(m, b)
+}
+func (m *PortStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *PortStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStatsRequest.Merge(m, src)
+}
+func (m *PortStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_PortStatsRequest.Size(m)
+}
+func (m *PortStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStatsRequest proto.InternalMessageInfo
+
+func (m *PortStatsRequest) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortStatsRequest) GetHistory() bool {
+	if m != nil {
+		return m.History
+	}
+	return false
+}
+
+type PortStats struct {
+	Ifname  string           `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Current *PortStatsSample `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	// Recent samples, oldest first
+	History              []*PortStatsSample `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PortStats) Reset()         { *m = PortStats{} }
+func (m *PortStats) String() string { return proto.CompactTextString(m) }
+func (*PortStats) ProtoMessage()    {}
+func (*PortStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{16}
+}
+
+func (m *PortStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStats.Unmarshal(m, b)
+}
+func (m *PortStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStats.Marshal(b, m, deterministic)
+}
+func (m *PortStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStats.Merge(m, src)
+}
+func (m *PortStats) XXX_Size() int {
+	return xxx_messageInfo_PortStats.Size(m)
+}
+func (m *PortStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStats proto.InternalMessageInfo
+
+func (m *PortStats) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortStats) GetCurrent() *PortStatsSample {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *PortStats) GetHistory() []*PortStatsSample {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type PortStatsList struct {
+	Stats                []*PortStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PortStatsList) Reset()         { *m = PortStatsList{} }
+func (m *PortStatsList) String() string { return proto.CompactTextString(m) }
+func (*PortStatsList) ProtoMessage()    {}
+func (*PortStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{17}
+}
+
+func (m *PortStatsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStatsList.Unmarshal(m, b)
+}
+func (m *PortStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStatsList.Marshal(b, m, deterministic)
+}
+func (m *PortStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStatsList.Merge(m, src)
+}
+func (m *PortStatsList) XXX_Size() int {
+	return xxx_messageInfo_PortStatsList.Size(m)
+}
+func (m *PortStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStatsList proto.InternalMessageInfo
+
+func (m *PortStatsList) GetStats() []*PortStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type PortStormControl struct {
+	Ifname string                `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Unit   PortStormControl_Unit `protobuf:"varint,2,opt,name=unit,proto3,enum=OpenNos.Switch.Port.PortStormControl_Unit" json:"unit,omitempty"`
+	// Thresholds in given unit, zero does not limit the traffic
+	Broadcast            uint32                  `protobuf:"varint,3,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Multicast            uint32                  `protobuf:"varint,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	UnknownUnicast       uint32                  `protobuf:"varint,5,opt,name=unknownUnicast,proto3" json:"unknownUnicast,omitempty"`
+	Action               PortStormControl_Action `protobuf:"varint,6,opt,name=action,proto3,enum=OpenNos.Switch.Port.PortStormControl_Action" json:"action,omitempty"`
+	AutoRecoverySec      uint32                  `protobuf:"varint,7,opt,name=autoRecoverySec,proto3" json:"autoRecoverySec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PortStormControl) Reset()         { *m = PortStormControl{} }
+func (m *PortStormControl) String() string { return proto.CompactTextString(m) }
+func (*PortStormControl) ProtoMessage()    {}
+func (*PortStormControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{18}
+}
+
+func (m *PortStormControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStormControl.Unmarshal(m, b)
+}
+func (m *PortStormControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStormControl.Marshal(b, m, deterministic)
+}
+func (m *PortStormControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStormControl.Merge(m, src)
+}
+func (m *PortStormControl) XXX_Size() int {
+	return xxx_messageInfo_PortStormControl.Size(m)
+}
+func (m *PortStormControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStormControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStormControl proto.InternalMessageInfo
+
+func (m *PortStormControl) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortStormControl) GetUnit() PortStormControl_Unit {
+	if m != nil {
+		return m.Unit
+	}
+	return PortStormControl_PPS
+}
+
+func (m *PortStormControl) GetBroadcast() uint32 {
+	if m != nil {
+		return m.Broadcast
+	}
+	return 0
+}
+
+func (m *PortStormControl) GetMulticast() uint32 {
+	if m != nil {
+		return m.Multicast
+	}
+	return 0
+}
+
+func (m *PortStormControl) GetUnknownUnicast() uint32 {
+	if m != nil {
+		return m.UnknownUnicast
+	}
+	return 0
+}
+
+func (m *PortStormControl) GetAction() PortStormControl_Action {
+	if m != nil {
+		return m.Action
+	}
+	return PortStormControl_DROP
+}
+
+func (m *PortStormControl) GetAutoRecoverySec() uint32 {
+	if m != nil {
+		return m.AutoRecoverySec
+	}
+	return 0
+}
+
+type PortStormControlStatus struct {
+	Config *PortStormControl `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Packets dropped by storm control of any traffic type
+	DroppedPkts          uint64   `protobuf:"varint,2,opt,name=droppedPkts,proto3" json:"droppedPkts,omitempty"`
+	Violations           uint64   `protobuf:"varint,3,opt,name=violations,proto3" json:"violations,omitempty"`
+	ErrDisabled          bool     `protobuf:"varint,4,opt,name=errDisabled,proto3" json:"errDisabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortStormControlStatus) Reset()         { *m = PortStormControlStatus{} }
+func (m *PortStormControlStatus) String() string { return proto.CompactTextString(m) }
+func (*PortStormControlStatus) ProtoMessage()    {}
+func (*PortStormControlStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{19}
+}
+
+func (m *PortStormControlStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStormControlStatus.Unmarshal(m, b)
+}
+func (m *PortStormControlStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStormControlStatus.Marshal(b, m, deterministic)
+}
+func (m *PortStormControlStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStormControlStatus.Merge(m, src)
+}
+func (m *PortStormControlStatus) XXX_Size() int {
+	return xxx_messageInfo_PortStormControlStatus.Size(m)
+}
+func (m *PortStormControlStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStormControlStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStormControlStatus proto.InternalMessageInfo
+
+func (m *PortStormControlStatus) GetConfig() *PortStormControl {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PortStormControlStatus) GetDroppedPkts() uint64 {
+	if m != nil {
+		return m.DroppedPkts
+	}
+	return 0
+}
+
+func (m *PortStormControlStatus) GetViolations() uint64 {
+	if m != nil {
+		return m.Violations
+	}
+	return 0
+}
+
+func (m *PortStormControlStatus) GetErrDisabled() bool {
+	if m != nil {
+		return m.ErrDisabled
+	}
+	return false
+}
+
+type PortStormControlList struct {
+	Statuses             []*PortStormControlStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PortStormControlList) Reset()         { *m = PortStormControlList{} }
+func (m *PortStormControlList) String() string { return proto.CompactTextString(m) }
+func (*PortStormControlList) ProtoMessage()    {}
+func (*PortStormControlList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{20}
+}
+
+func (m *PortStormControlList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortStormControlList.Unmarshal(m, b)
+}
+func (m *PortStormControlList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortStormControlList.Marshal(b, m, deterministic)
+}
+func (m *PortStormControlList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortStormControlList.Merge(m, src)
+}
+func (m *PortStormControlList) XXX_Size() int {
+	return xxx_messageInfo_PortStormControlList.Size(m)
+}
+func (m *PortStormControlList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortStormControlList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortStormControlList proto.InternalMessageInfo
+
+func (m *PortStormControlList) GetStatuses() []*PortStormControlStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type PortSelfTest struct {
+	Ifname   string                `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Loopback PortSelfTest_Loopback `protobuf:"varint,2,opt,name=loopback,proto3,enum=OpenNos.Switch.Port.PortSelfTest_Loopback" json:"loopback,omitempty"`
+	// Number of test frames, zero sends default number
+	Frames uint32 `protobuf:"varint,3,opt,name=frames,proto3" json:"frames,omitempty"`
+	// Size of test frames in octets, zero uses default size
+	FrameSize uint32 `protobuf:"varint,4,opt,name=frameSize,proto3" json:"frameSize,omitempty"`
+	// Runs the test also on LAG member or forwarding port
+	Force                bool     `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortSelfTest) Reset()         { *m = PortSelfTest{} }
+func (m *PortSelfTest) String() string { return proto.CompactTextString(m) }
+func (*PortSelfTest) ProtoMessage()    {}
+func (*PortSelfTest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{21}
+}
+
+func (m *PortSelfTest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSelfTest.Unmarshal(m, b)
+}
+func (m *PortSelfTest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSelfTest.Marshal(b, m, deterministic)
+}
+func (m *PortSelfTest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSelfTest.Merge(m, src)
+}
+func (m *PortSelfTest) XXX_Size() int {
+	return xxx_messageInfo_PortSelfTest.Size(m)
+}
+func (m *PortSelfTest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSelfTest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSelfTest proto.InternalMessageInfo
+
+func (m *PortSelfTest) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortSelfTest) GetLoopback() PortSelfTest_Loopback {
+	if m != nil {
+		return m.Loopback
+	}
+	return PortSelfTest_MAC
+}
+
+func (m *PortSelfTest) GetFrames() uint32 {
+	if m != nil {
+		return m.Frames
+	}
+	return 0
+}
+
+func (m *PortSelfTest) GetFrameSize() uint32 {
+	if m != nil {
+		return m.FrameSize
+	}
+	return 0
+}
+
+func (m *PortSelfTest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type PortSelfTestResult struct {
+	Ifname   string `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Passed   bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Sent     uint32 `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	Received uint32 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	// Frames received with corrupted payload
+	Corrupted uint32 `protobuf:"varint,5,opt,name=corrupted,proto3" json:"corrupted,omitempty"`
+	// Errors counted by port during the test
+	RxErrors             uint64   `protobuf:"varint,6,opt,name=rxErrors,proto3" json:"rxErrors,omitempty"`
+	FcsErrors            uint64   `protobuf:"varint,7,opt,name=fcsErrors,proto3" json:"fcsErrors,omitempty"`
+	TxErrors             uint64   `protobuf:"varint,8,opt,name=txErrors,proto3" json:"txErrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortSelfTestResult) Reset()         { *m = PortSelfTestResult{} }
+func (m *PortSelfTestResult) String() string { return proto.CompactTextString(m) }
+func (*PortSelfTestResult) ProtoMessage()    {}
+func (*PortSelfTestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{22}
+}
+
+func (m *PortSelfTestResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSelfTestResult.Unmarshal(m, b)
+}
+func (m *PortSelfTestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSelfTestResult.Marshal(b, m, deterministic)
+}
+func (m *PortSelfTestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSelfTestResult.Merge(m, src)
+}
+func (m *PortSelfTestResult) XXX_Size() int {
+	return xxx_messageInfo_PortSelfTestResult.Size(m)
+}
+func (m *PortSelfTestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSelfTestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSelfTestResult proto.InternalMessageInfo
+
+func (m *PortSelfTestResult) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *PortSelfTestResult) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *PortSelfTestResult) GetSent() uint32 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *PortSelfTestResult) GetReceived() uint32 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *PortSelfTestResult) GetCorrupted() uint32 {
+	if m != nil {
+		return m.Corrupted
+	}
+	return 0
+}
+
+func (m *PortSelfTestResult) GetRxErrors() uint64 {
+	if m != nil {
+		return m.RxErrors
+	}
+	return 0
+}
+
+func (m *PortSelfTestResult) GetFcsErrors() uint64 {
+	if m != nil {
+		return m.FcsErrors
+	}
+	return 0
+}
+
+func (m *PortSelfTestResult) GetTxErrors() uint64 {
+	if m != nil {
+		return m.TxErrors
+	}
+	return 0
+}
+
+type PortResult struct {
+	Result               PortResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.Port.PortResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PortResult) Reset()         { *m = PortResult{} }
+func (m *PortResult) String() string { return proto.CompactTextString(m) }
+func (*PortResult) ProtoMessage()    {}
+func (*PortResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5385ee6fcde7a322, []int{23}
+}
+
+func (m *PortResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortResult.Unmarshal(m, b)
+}
+func (m *PortResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortResult.Marshal(b, m, deterministic)
+}
+func (m *PortResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortResult.Merge(m, src)
+}
+func (m *PortResult) XXX_Size() int {
+	return xxx_messageInfo_PortResult.Size(m)
+}
+func (m *PortResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortResult proto.InternalMessageInfo
+
+func (m *PortResult) GetResult() PortResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return PortResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.Port.PortSpeed_Duplex", PortSpeed_Duplex_name, PortSpeed_Duplex_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortFec_Mode", PortFec_Mode_name, PortFec_Mode_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortStatus_OperStatus", PortStatus_OperStatus_name, PortStatus_OperStatus_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortStormControl_Unit", PortStormControl_Unit_name, PortStormControl_Unit_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortStormControl_Action", PortStormControl_Action_name, PortStormControl_Action_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortSelfTest_Loopback", PortSelfTest_Loopback_name, PortSelfTest_Loopback_value)
+	proto.RegisterEnum("OpenNos.Switch.Port.PortResult_Result", PortResult_Result_name, PortResult_Result_value)
+	proto.RegisterType((*PortIface)(nil), "OpenNos.Switch.Port.PortIface")
+	proto.RegisterType((*PortAdminState)(nil), "OpenNos.Switch.Port.PortAdminState")
+	proto.RegisterType((*PortSpeed)(nil), "OpenNos.Switch.Port.PortSpeed")
+	proto.RegisterType((*PortFec)(nil), "OpenNos.Switch.Port.PortFec")
+	proto.RegisterType((*PortAutoneg)(nil), "OpenNos.Switch.Port.PortAutoneg")
+	proto.RegisterType((*PortMtu)(nil), "OpenNos.Switch.Port.PortMtu")
+	proto.RegisterType((*PortFlowControl)(nil), "OpenNos.Switch.Port.PortFlowControl")
+	proto.RegisterType((*PortConfig)(nil), "OpenNos.Switch.Port.PortConfig")
+	proto.RegisterType((*PortConfigList)(nil), "OpenNos.Switch.Port.PortConfigList")
+	proto.RegisterType((*PortStatus)(nil), "OpenNos.Switch.Port.PortStatus")
+	proto.RegisterType((*PortStatusList)(nil), "OpenNos.Switch.Port.PortStatusList")
+	proto.RegisterType((*PortLinkEvent)(nil), "OpenNos.Switch.Port.PortLinkEvent")
+	proto.RegisterType((*PortCounters)(nil), "OpenNos.Switch.Port.PortCounters")
+	proto.RegisterType((*PortRates)(nil), "OpenNos.Switch.Port.PortRates")
+	proto.RegisterType((*PortStatsSample)(nil), "OpenNos.Switch.Port.PortStatsSample")
+	proto.RegisterType((*PortStatsRequest)(nil), "OpenNos.Switch.Port.PortStatsRequest")
+	proto.RegisterType((*PortStats)(nil), "OpenNos.Switch.Port.PortStats")
+	proto.RegisterType((*PortStatsList)(nil), "OpenNos.Switch.Port.PortStatsList")
+	proto.RegisterType((*PortStormControl)(nil), "OpenNos.Switch.Port.PortStormControl")
+	proto.RegisterType((*PortStormControlStatus)(nil), "OpenNos.Switch.Port.PortStormControlStatus")
+	proto.RegisterType((*PortStormControlList)(nil), "OpenNos.Switch.Port.PortStormControlList")
+	proto.RegisterType((*PortSelfTest)(nil), "OpenNos.Switch.Port.PortSelfTest")
+	proto.RegisterType((*PortSelfTestResult)(nil), "OpenNos.Switch.Port.PortSelfTestResult")
+	proto.RegisterType((*PortResult)(nil), "OpenNos.Switch.Port.PortResult")
+}
+
+func init() { proto.RegisterFile("port_management.proto", fileDescriptor_5385ee6fcde7a322) }
+
+var fileDescriptor_5385ee6fcde7a322 = []byte{
+	// 1813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xef, 0x6e, 0xdb, 0xc8,
+	0x11, 0x37, 0x2d, 0x59, 0x92, 0x47, 0x96, 0xcc, 0xee, 0xa5, 0x01, 0x61, 0x18, 0x39, 0x65, 0x73,
+	0x77, 0x75, 0xaf, 0x85, 0x10, 0x29, 0xd1, 0xf5, 0xae, 0x45, 0x82, 0x3a, 0x96, 0x95, 0x73, 0x6b,
+	0xd9, 0xea, 0x32, 0x6a, 0x70, 0x08, 0xda, 0x80, 0xa1, 0x56, 0x09, 0x61, 0x89, 0xcb, 0x92, 0x4b,
+	0xc7, 0xd7, 0x7e, 0xea, 0x2b, 0xb4, 0x40, 0x5f, 0xa0, 0xf7, 0x16, 0x7d, 0x87, 0x7e, 0xe9, 0x97,
+	0x3e, 0x42, 0x1f, 0xa3, 0xd8, 0x3f, 0xa4, 0x28, 0xb9, 0x14, 0xd5, 0x7c, 0xb8, 0x4f, 0xda, 0xf9,
+	0x69, 0x66, 0x76, 0xfe, 0xed, 0xec, 0x2c, 0xe1, 0x87, 0x01, 0x0b, 0xf9, 0xeb, 0xb9, 0xe3, 0x3b,
+	0x6f, 0xe9, 0x9c, 0xfa, 0xbc, 0x1d, 0x84, 0x8c, 0x33, 0xf4, 0xd1, 0x65, 0x40, 0xfd, 0x0b, 0x16,
+	0xb5, 0xed, 0xf7, 0x1e, 0x77, 0xdf, 0xb5, 0x47, 0x2c, 0xe4, 0xf8, 0x01, 0xec, 0x8a, 0xdf, 0xb3,
+	0xa9, 0xe3, 0x52, 0x74, 0x17, 0x2a, 0xde, 0xd4, 0x77, 0xe6, 0xd4, 0x32, 0x5a, 0xc6, 0xd1, 0x2e,
+	0xd1, 0x14, 0x7e, 0x06, 0x4d, 0xc1, 0x74, 0x3c, 0x99, 0x7b, 0xbe, 0xcd, 0x1d, 0x9e, 0xcb, 0x89,
+	0x2c, 0xa8, 0x52, 0xdf, 0x79, 0x33, 0xa3, 0x13, 0x6b, 0xbb, 0x65, 0x1c, 0xd5, 0x48, 0x42, 0xe2,
+	0xbf, 0x19, 0x6a, 0x27, 0x3b, 0xa0, 0x74, 0x92, 0x2b, 0x7f, 0x07, 0x76, 0xa2, 0x80, 0x6a, 0xe9,
+	0x06, 0x51, 0x04, 0x7a, 0x02, 0x95, 0x49, 0x1c, 0xcc, 0xe8, 0x8d, 0x55, 0x6a, 0x19, 0x47, 0xcd,
+	0xee, 0xa7, 0xed, 0xff, 0xe1, 0x4a, 0x3b, 0xd5, 0xde, 0xee, 0x4b, 0x66, 0xa2, 0x85, 0xf0, 0x21,
+	0x54, 0x14, 0x82, 0x6a, 0x50, 0x1e, 0x8c, 0xcf, 0xcf, 0xcd, 0x2d, 0xb1, 0xfa, 0xfa, 0xf8, 0x7c,
+	0x60, 0x1a, 0xf8, 0x4f, 0x50, 0x15, 0x92, 0x03, 0xea, 0xe6, 0x5a, 0xd5, 0x83, 0xf2, 0x9c, 0x4d,
+	0xa8, 0x34, 0xaa, 0xd9, 0xbd, 0x9f, 0xbb, 0xfb, 0x80, 0xba, 0xed, 0x21, 0x9b, 0x50, 0x22, 0xd9,
+	0xf1, 0x7d, 0x28, 0x0b, 0x0a, 0x55, 0xa1, 0x74, 0x39, 0x18, 0x98, 0x5b, 0xa8, 0x02, 0xdb, 0x97,
+	0x17, 0xa6, 0x21, 0x36, 0x3f, 0x1e, 0xbf, 0xb8, 0x34, 0xb7, 0xf1, 0x5f, 0x0d, 0xa8, 0xcb, 0xd0,
+	0xc6, 0x9c, 0xf9, 0xf4, 0xed, 0xff, 0x1f, 0x57, 0xf4, 0x39, 0x98, 0xce, 0xe4, 0x9a, 0x86, 0xdc,
+	0x8b, 0xe8, 0x44, 0xba, 0x1f, 0x59, 0xa5, 0x56, 0xe9, 0xa8, 0x41, 0x6e, 0xe1, 0xe8, 0x33, 0x68,
+	0xa6, 0xd8, 0xc8, 0x89, 0x23, 0x6a, 0x95, 0xa5, 0xb2, 0x15, 0x14, 0x3f, 0x52, 0x21, 0x19, 0xf2,
+	0x38, 0xd7, 0x20, 0x13, 0x4a, 0x73, 0x1e, 0xeb, 0x34, 0x89, 0x25, 0x3e, 0x83, 0x7d, 0x19, 0x83,
+	0x19, 0x7b, 0x7f, 0xc2, 0x7c, 0x1e, 0xb2, 0x59, 0xae, 0x70, 0x13, 0xb6, 0xf9, 0x8d, 0x76, 0x64,
+	0x9b, 0xdf, 0x08, 0x3a, 0x54, 0xb9, 0xad, 0x91, 0xed, 0xf0, 0x06, 0x7f, 0x57, 0x02, 0x10, 0xba,
+	0x4e, 0x98, 0x3f, 0xf5, 0x3e, 0x24, 0x28, 0x8f, 0x93, 0x32, 0x12, 0x3a, 0xeb, 0xdd, 0x7b, 0xeb,
+	0xeb, 0x25, 0x29, 0xb3, 0x36, 0x94, 0xa6, 0xd4, 0x95, 0x31, 0xa9, 0x77, 0x0f, 0xd7, 0x65, 0x99,
+	0x08, 0x46, 0xf4, 0x73, 0xa8, 0x3a, 0x2a, 0x6f, 0xd6, 0x8e, 0x94, 0x69, 0xe5, 0xca, 0xe8, 0xfc,
+	0x92, 0x44, 0x00, 0xb5, 0x55, 0xfc, 0x2a, 0x05, 0x7b, 0x0d, 0x79, 0x2c, 0xa3, 0x8b, 0x06, 0x50,
+	0x9f, 0x2e, 0x22, 0x6b, 0x55, 0xa5, 0xdc, 0x27, 0xf9, 0x36, 0x2e, 0x78, 0x49, 0x56, 0x10, 0x1d,
+	0xc1, 0x7e, 0x14, 0x07, 0xa2, 0x41, 0xa4, 0xd5, 0x52, 0x93, 0xd5, 0xb2, 0x0a, 0xa3, 0x16, 0xd4,
+	0x69, 0x18, 0xf6, 0xbd, 0x48, 0x45, 0x78, 0x57, 0x46, 0x38, 0x0b, 0xe1, 0x5f, 0x43, 0x73, 0x91,
+	0xa5, 0x73, 0x2f, 0xe2, 0xe8, 0x2b, 0xa8, 0xba, 0x92, 0x8a, 0x2c, 0xa3, 0x55, 0x3a, 0xaa, 0x77,
+	0x3f, 0xce, 0xb5, 0x50, 0x49, 0x91, 0x84, 0x1f, 0xff, 0x45, 0xe7, 0x5c, 0xf4, 0x97, 0x38, 0xca,
+	0xcd, 0xf9, 0xaf, 0x00, 0x58, 0x40, 0x43, 0xc5, 0xa5, 0x0f, 0xe4, 0xe7, 0xf9, 0xe9, 0x95, 0x6c,
+	0xed, 0xcb, 0x54, 0x82, 0x64, 0xa4, 0xbf, 0xa7, 0x2a, 0xb9, 0x07, 0x30, 0xf3, 0xfc, 0xab, 0x71,
+	0xf0, 0xc2, 0x9b, 0x53, 0x59, 0x28, 0x25, 0x92, 0x41, 0x44, 0xcb, 0x9b, 0xce, 0x9c, 0x20, 0x92,
+	0xb5, 0x50, 0x26, 0x8a, 0x40, 0x08, 0xca, 0x22, 0x1b, 0x32, 0xd1, 0x3b, 0x44, 0xae, 0x05, 0xe7,
+	0x5b, 0x09, 0xd6, 0x24, 0xa8, 0x08, 0x71, 0x0a, 0xbc, 0xa9, 0xe7, 0x4f, 0xe8, 0x8d, 0xcc, 0xd1,
+	0x0e, 0x49, 0x48, 0xfc, 0x4b, 0x80, 0x85, 0xe7, 0xa2, 0xe9, 0xf4, 0x2f, 0x5f, 0x5e, 0xa8, 0x36,
+	0x34, 0x1e, 0x99, 0x06, 0x6a, 0x02, 0x1c, 0xf7, 0x87, 0x67, 0x17, 0xaf, 0x25, 0xbe, 0x8d, 0x4c,
+	0xd8, 0x3b, 0x25, 0xe4, 0x75, 0xff, 0xcc, 0x3e, 0x7e, 0x76, 0x7e, 0xda, 0x37, 0x4b, 0x78, 0xa8,
+	0x32, 0xac, 0x34, 0xc8, 0x0c, 0xff, 0x02, 0x6a, 0x91, 0xa4, 0x68, 0x71, 0x8a, 0x75, 0xc8, 0x53,
+	0x01, 0xfc, 0x3b, 0x68, 0x08, 0xfc, 0xdc, 0xf3, 0xaf, 0x4e, 0xaf, 0xa9, 0xcf, 0x73, 0xb3, 0x7c,
+	0x17, 0x2a, 0x2a, 0x42, 0xfa, 0x60, 0x6b, 0x0a, 0x1d, 0xc2, 0x2e, 0xf7, 0xe6, 0x34, 0xe2, 0xce,
+	0x3c, 0x90, 0x59, 0x2b, 0x91, 0x05, 0x80, 0xff, 0xb9, 0x03, 0x7b, 0xaa, 0xb4, 0x62, 0x9f, 0xd3,
+	0x30, 0x42, 0x07, 0x50, 0xf3, 0xfc, 0x4b, 0x97, 0x53, 0x1e, 0xc9, 0x0d, 0xca, 0x24, 0xa5, 0x45,
+	0x79, 0x7b, 0xfe, 0xd8, 0x75, 0x22, 0x3e, 0xba, 0xe2, 0xaa, 0x92, 0xca, 0x24, 0x0b, 0x89, 0xa3,
+	0xe2, 0xf9, 0xc3, 0x78, 0xc6, 0xbd, 0x94, 0xab, 0x24, 0xb9, 0x56, 0x61, 0xc5, 0xf9, 0x2c, 0x64,
+	0xce, 0x24, 0xe5, 0x2c, 0x27, 0x9c, 0x4b, 0xb0, 0x28, 0x06, 0xcf, 0xef, 0x7b, 0x91, 0xeb, 0x84,
+	0x93, 0x48, 0x16, 0x43, 0x99, 0x64, 0x10, 0x65, 0xf1, 0x69, 0x18, 0xb2, 0x30, 0xa9, 0x87, 0x94,
+	0x56, 0x16, 0x0f, 0xdc, 0x48, 0xff, 0x5d, 0x4d, 0x2c, 0x4e, 0x21, 0x11, 0x1e, 0x16, 0x73, 0xed,
+	0x70, 0x4d, 0xfe, 0xbf, 0x00, 0x10, 0x86, 0x3d, 0x16, 0xf3, 0x85, 0xcb, 0xbb, 0x92, 0x61, 0x09,
+	0x13, 0xb7, 0x09, 0x8b, 0xf9, 0xb2, 0xd3, 0x20, 0xf9, 0x6e, 0xe1, 0x9a, 0x77, 0xd9, 0xed, 0x7a,
+	0xca, 0xbb, 0xec, 0x77, 0x0b, 0xea, 0x2c, 0xe6, 0xa9, 0xe3, 0x7b, 0xca, 0xf6, 0x0c, 0xa4, 0x6d,
+	0xd7, 0xbe, 0x35, 0x52, 0xdb, 0xb5, 0x67, 0x77, 0xa1, 0x12, 0x5c, 0xf1, 0xe8, 0x8b, 0xc7, 0x56,
+	0x53, 0xfe, 0xa5, 0x29, 0xa1, 0x57, 0xae, 0x7a, 0x9c, 0x75, 0xba, 0x3f, 0xb3, 0xf6, 0x95, 0xde,
+	0x0c, 0x24, 0xbc, 0x16, 0x64, 0xa7, 0xfb, 0x25, 0x67, 0xdd, 0x5e, 0xcf, 0x32, 0x95, 0xd7, 0x59,
+	0x2c, 0xe1, 0xe9, 0xf6, 0xbe, 0xe0, 0xac, 0xd7, 0xe9, 0x58, 0x3f, 0x58, 0xf0, 0x24, 0x18, 0xfa,
+	0x04, 0x1a, 0x82, 0xee, 0x75, 0xba, 0x9c, 0x75, 0x1e, 0x76, 0x1f, 0x59, 0x48, 0x32, 0x2d, 0x83,
+	0xe2, 0x86, 0x95, 0x9a, 0x1f, 0x76, 0x1f, 0x73, 0xd6, 0xe9, 0x75, 0xbe, 0xb4, 0x3e, 0x92, 0x6c,
+	0x2b, 0x68, 0xa2, 0xad, 0xd3, 0xeb, 0x7c, 0xc5, 0xd9, 0xd0, 0xb9, 0xb1, 0xee, 0x2c, 0xb4, 0xa5,
+	0x20, 0x7e, 0xab, 0x46, 0x26, 0xe2, 0x70, 0x1a, 0x89, 0xd3, 0xef, 0xf9, 0xcf, 0x82, 0xa4, 0x92,
+	0x15, 0x21, 0x02, 0x23, 0x82, 0x1d, 0x24, 0x15, 0xac, 0x29, 0xc5, 0x3d, 0x0a, 0x92, 0x92, 0x55,
+	0x84, 0xe6, 0x1e, 0x05, 0x49, 0x7d, 0x6a, 0x0a, 0x7f, 0x67, 0xc0, 0x7e, 0x72, 0x62, 0x23, 0xdb,
+	0x99, 0x07, 0x33, 0xba, 0x7c, 0xd6, 0x8c, 0x95, 0xb3, 0x86, 0x9e, 0x40, 0xcd, 0xd5, 0xc7, 0x4c,
+	0xee, 0x5c, 0x5f, 0x33, 0x16, 0x25, 0xe7, 0x91, 0xa4, 0x22, 0xa2, 0xf5, 0x86, 0xc2, 0xab, 0xc2,
+	0xd6, 0x2b, 0x7d, 0x27, 0x8a, 0x19, 0xf7, 0xc1, 0x4c, 0xad, 0x24, 0xf4, 0x0f, 0x31, 0x8d, 0xf8,
+	0xba, 0xe1, 0xe0, 0x9d, 0x17, 0x71, 0x16, 0x7e, 0x9b, 0x0c, 0x07, 0x9a, 0xc4, 0x7f, 0x4f, 0x26,
+	0x51, 0xa1, 0x26, 0x57, 0xfe, 0x29, 0x54, 0xdd, 0x38, 0x0c, 0xa9, 0xcf, 0xad, 0xed, 0x82, 0xcb,
+	0x36, 0x13, 0x35, 0x92, 0x08, 0x09, 0xf9, 0x64, 0xff, 0x52, 0xab, 0xb4, 0xb9, 0x7c, 0x62, 0xe5,
+	0x29, 0x34, 0xd2, 0xff, 0x64, 0xe7, 0x15, 0xb7, 0x95, 0x20, 0x74, 0xdb, 0xbd, 0xb7, 0x5e, 0x1d,
+	0x51, 0xcc, 0xf8, 0xcf, 0xa5, 0x24, 0x66, 0x2c, 0x9c, 0x17, 0xcd, 0x65, 0x4f, 0xa1, 0x1c, 0xfb,
+	0x1e, 0xdf, 0xe0, 0x5a, 0x5d, 0x28, 0x6b, 0x8f, 0x7d, 0x8f, 0x13, 0x29, 0x27, 0x4a, 0xe6, 0x4d,
+	0x72, 0xec, 0x65, 0x66, 0x1b, 0x64, 0x01, 0x88, 0x7f, 0xe7, 0x49, 0x03, 0x91, 0xf5, 0xd7, 0x20,
+	0x0b, 0x40, 0x9c, 0x9c, 0xd8, 0xbf, 0xf2, 0xd9, 0x7b, 0x7f, 0xec, 0x2b, 0x96, 0x1d, 0xc9, 0xb2,
+	0x82, 0xa2, 0x3e, 0x54, 0x1c, 0x97, 0x7b, 0xcc, 0x97, 0xfd, 0xb1, 0xd9, 0xfd, 0xe9, 0x66, 0x56,
+	0x1e, 0x4b, 0x19, 0xa2, 0x65, 0x45, 0xc7, 0x16, 0x93, 0x18, 0xa1, 0x2e, 0xbb, 0xa6, 0xe1, 0xb7,
+	0x36, 0x75, 0x65, 0x3f, 0x6d, 0x90, 0x55, 0x18, 0x1f, 0x42, 0x59, 0x78, 0x28, 0x86, 0xf8, 0xd1,
+	0xc8, 0x36, 0xb7, 0x50, 0x1d, 0xaa, 0xa3, 0x53, 0x72, 0x72, 0x7a, 0xf1, 0xc2, 0x34, 0xf0, 0x03,
+	0xa8, 0x28, 0xcd, 0xf2, 0x7a, 0x25, 0x97, 0x23, 0x73, 0x0b, 0xed, 0x43, 0x3d, 0x73, 0x8d, 0x9a,
+	0x06, 0xfe, 0x87, 0x01, 0x77, 0x57, 0x0d, 0xd2, 0x97, 0xf2, 0x13, 0xa8, 0xa8, 0x01, 0x48, 0x66,
+	0xa2, 0xbe, 0xee, 0x65, 0x93, 0x11, 0x26, 0x5a, 0x48, 0xb4, 0xbf, 0x49, 0xc8, 0x82, 0x80, 0x4e,
+	0xb2, 0x97, 0x58, 0x06, 0x12, 0x17, 0xce, 0xb5, 0xc7, 0x66, 0x8e, 0xb0, 0x31, 0x69, 0x06, 0x19,
+	0x64, 0x75, 0xca, 0x2b, 0xdf, 0x9e, 0xf2, 0x5e, 0xc3, 0x9d, 0xd5, 0xfd, 0x65, 0x3d, 0x3e, 0xbf,
+	0x35, 0x09, 0xfc, 0x64, 0x23, 0xe3, 0x6f, 0x4d, 0x05, 0xff, 0x36, 0xd4, 0xb5, 0x6d, 0xd3, 0xd9,
+	0xf4, 0xc5, 0xba, 0x23, 0x3d, 0x80, 0xda, 0x8c, 0xb1, 0xe0, 0x8d, 0xe3, 0x5e, 0x15, 0x97, 0xa8,
+	0x56, 0xd6, 0x3e, 0xd7, 0x12, 0x24, 0x95, 0x15, 0xfa, 0xa7, 0xa1, 0x33, 0xd7, 0xdd, 0xa7, 0x41,
+	0x34, 0x25, 0x0a, 0x54, 0xae, 0x6c, 0xef, 0x8f, 0x34, 0x29, 0xd0, 0x14, 0x90, 0x73, 0x1a, 0x0b,
+	0x5d, 0x35, 0xc2, 0xd5, 0x88, 0x22, 0xf0, 0x21, 0xd4, 0x92, 0x1d, 0x44, 0x89, 0x0c, 0x8f, 0x4f,
+	0xcc, 0x2d, 0xb1, 0x18, 0x7d, 0xfd, 0x8d, 0x69, 0xe0, 0xff, 0x18, 0x80, 0xb2, 0xd6, 0x10, 0x1a,
+	0xc5, 0xb3, 0xb5, 0x63, 0x4f, 0xe0, 0x44, 0x51, 0xfa, 0x9e, 0xd1, 0x94, 0x18, 0x06, 0x23, 0xd1,
+	0x88, 0x94, 0xb9, 0x72, 0x2d, 0x26, 0x85, 0x90, 0xba, 0xd4, 0xbb, 0xd6, 0x59, 0x6b, 0x90, 0x94,
+	0x16, 0x8e, 0xb8, 0x2c, 0x0c, 0xe3, 0x80, 0xd3, 0x89, 0x3e, 0x46, 0x0b, 0x40, 0x4a, 0xde, 0x2c,
+	0xcf, 0x18, 0x09, 0x2d, 0x43, 0xb0, 0x32, 0x61, 0x2c, 0x00, 0x21, 0xc9, 0x13, 0x49, 0x35, 0x5e,
+	0xa4, 0x34, 0x66, 0x6a, 0x7c, 0xd7, 0x1e, 0x3e, 0x85, 0x4a, 0x28, 0x57, 0xd2, 0xc3, 0x66, 0xf7,
+	0xb3, 0xfc, 0x06, 0x2f, 0xd9, 0xda, 0xea, 0x87, 0x68, 0x29, 0x7c, 0x1f, 0x2a, 0x5a, 0x13, 0x40,
+	0x65, 0x70, 0x7c, 0x26, 0xc6, 0x51, 0x79, 0xf4, 0xec, 0xf1, 0xc9, 0xc9, 0xa9, 0x6d, 0x9b, 0x46,
+	0xf7, 0x5f, 0xbb, 0x6a, 0x38, 0x1d, 0xa6, 0xdf, 0x39, 0xd0, 0x4b, 0x68, 0xd8, 0x34, 0xfb, 0x99,
+	0xe2, 0x41, 0xfe, 0x83, 0x2c, 0x65, 0x3a, 0xf8, 0xb8, 0xc0, 0x36, 0xbc, 0x85, 0x86, 0x50, 0xb3,
+	0xa9, 0xfe, 0x74, 0x51, 0xf0, 0x4c, 0xd8, 0x44, 0xdd, 0x19, 0x54, 0x6c, 0x2a, 0xbf, 0x38, 0xac,
+	0x7d, 0x3f, 0x6c, 0xa2, 0xea, 0x37, 0x00, 0xc2, 0x65, 0xfd, 0xaa, 0x2c, 0x7c, 0x80, 0x6e, 0x6e,
+	0x9d, 0x78, 0xfc, 0xaf, 0x7d, 0x97, 0x6e, 0xa2, 0xea, 0x1b, 0x68, 0x0a, 0x47, 0x33, 0xef, 0xcf,
+	0x8d, 0x9e, 0xac, 0x9b, 0xa8, 0xfe, 0x2d, 0x34, 0x9e, 0x53, 0x9e, 0xf9, 0x4a, 0x90, 0x9f, 0x17,
+	0xf9, 0x71, 0xeb, 0xe0, 0x41, 0xc1, 0x53, 0x54, 0x34, 0xb5, 0x25, 0xbd, 0xba, 0x45, 0x7f, 0xb8,
+	0xde, 0xc5, 0xb3, 0x49, 0x86, 0x62, 0xff, 0xa5, 0xc3, 0xdd, 0x77, 0xe9, 0xe3, 0xa7, 0x58, 0x33,
+	0xce, 0xfd, 0x3f, 0x55, 0x82, 0xb7, 0x1e, 0x1a, 0xe8, 0x15, 0xec, 0x65, 0x4c, 0x8e, 0xd0, 0xa7,
+	0x05, 0xa3, 0x81, 0x9a, 0x9c, 0x0e, 0xf0, 0x7a, 0x36, 0x6d, 0xb7, 0x0d, 0xcd, 0x93, 0x19, 0x75,
+	0xc2, 0x85, 0xfa, 0x22, 0xb3, 0x37, 0x48, 0xde, 0x2b, 0xd8, 0xb7, 0xe9, 0xd2, 0xad, 0x80, 0x36,
+	0xbb, 0xf9, 0x36, 0x51, 0xfe, 0x7b, 0xd8, 0x7f, 0xbe, 0xa2, 0xbc, 0xc8, 0xe4, 0x1f, 0x6f, 0xb4,
+	0xb9, 0x8e, 0xc8, 0x2b, 0xa8, 0x93, 0xd8, 0x4f, 0x6f, 0xab, 0xfb, 0x85, 0x77, 0xd0, 0xc1, 0x8f,
+	0x0a, 0x59, 0x12, 0xe3, 0xdf, 0x54, 0xe4, 0xb7, 0xda, 0x47, 0xff, 0x1d, 0x00, 0x41, 0x2a, 0x9e,
+	0x6c, 0xc4, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PortManagementClient is the client API for PortManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PortManagementClient interface {
+	SetAdminState(ctx context.Context, in *PortAdminState, opts ...grpc.CallOption) (*PortResult, error)
+	// Forces speed and duplex of port with autonegotiation disabled
+	SetSpeed(ctx context.Context, in *PortSpeed, opts ...grpc.CallOption) (*PortResult, error)
+	SetFec(ctx context.Context, in *PortFec, opts ...grpc.CallOption) (*PortResult, error)
+	SetAutoneg(ctx context.Context, in *PortAutoneg, opts ...grpc.CallOption) (*PortResult, error)
+	SetMtu(ctx context.Context, in *PortMtu, opts ...grpc.CallOption) (*PortResult, error)
+	SetFlowControl(ctx context.Context, in *PortFlowControl, opts ...grpc.CallOption) (*PortResult, error)
+	// Empty interface name returns configuration of all ports
+	GetPortConfig(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortConfigList, error)
+	// Empty interface name returns status of all ports
+	GetPortStatus(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortStatusList, error)
+	// Empty interface name streams link events of all ports
+	WatchLinkEvents(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (PortManagement_WatchLinkEventsClient, error)
+	GetPortStats(ctx context.Context, in *PortStatsRequest, opts ...grpc.CallOption) (*PortStatsList, error)
+	// Empty interface name clears counters of all ports
+	ClearPortStats(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortResult, error)
+	SetStormControl(ctx context.Context, in *PortStormControl, opts ...grpc.CallOption) (*PortResult, error)
+	// Empty interface name returns storm control of all ports
+	GetStormControl(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortStormControlList, error)
+	// Loops frames sent from CPU back on port, port is restored afterwards
+	RunSelfTest(ctx context.Context, in *PortSelfTest, opts ...grpc.CallOption) (*PortSelfTestResult, error)
+}
+
+type portManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPortManagementClient(cc *grpc.ClientConn) PortManagementClient {
+	return &portManagementClient{cc}
+}
+
+func (c *portManagementClient) SetAdminState(ctx context.Context, in *PortAdminState, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetAdminState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetSpeed(ctx context.Context, in *PortSpeed, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetSpeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetFec(ctx context.Context, in *PortFec, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetFec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetAutoneg(ctx context.Context, in *PortAutoneg, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetAutoneg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetMtu(ctx context.Context, in *PortMtu, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetMtu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetFlowControl(ctx context.Context, in *PortFlowControl, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetFlowControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) GetPortConfig(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortConfigList, error) {
+	out := new(PortConfigList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/GetPortConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) GetPortStatus(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortStatusList, error) {
+	out := new(PortStatusList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/GetPortStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) WatchLinkEvents(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (PortManagement_WatchLinkEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PortManagement_serviceDesc.Streams[0], "/OpenNos.Switch.Port.PortManagement/WatchLinkEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &portManagementWatchLinkEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PortManagement_WatchLinkEventsClient interface {
+	Recv() (*PortLinkEvent, error)
+	grpc.ClientStream
+}
+
+type portManagementWatchLinkEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *portManagementWatchLinkEventsClient) Recv() (*PortLinkEvent, error) {
+	m := new(PortLinkEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *portManagementClient) GetPortStats(ctx context.Context, in *PortStatsRequest, opts ...grpc.CallOption) (*PortStatsList, error) {
+	out := new(PortStatsList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/GetPortStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) ClearPortStats(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/ClearPortStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) SetStormControl(ctx context.Context, in *PortStormControl, opts ...grpc.CallOption) (*PortResult, error) {
+	out := new(PortResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/SetStormControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) GetStormControl(ctx context.Context, in *PortIface, opts ...grpc.CallOption) (*PortStormControlList, error) {
+	out := new(PortStormControlList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/GetStormControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portManagementClient) RunSelfTest(ctx context.Context, in *PortSelfTest, opts ...grpc.CallOption) (*PortSelfTestResult, error) {
+	out := new(PortSelfTestResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Port.PortManagement/RunSelfTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PortManagementServer is the server API for PortManagement service.
+type PortManagementServer interface {
+	SetAdminState(context.Context, *PortAdminState) (*PortResult, error)
+	// Forces speed and duplex of port with autonegotiation disabled
+	SetSpeed(context.Context, *PortSpeed) (*PortResult, error)
+	SetFec(context.Context, *PortFec) (*PortResult, error)
+	SetAutoneg(context.Context, *PortAutoneg) (*PortResult, error)
+	SetMtu(context.Context, *PortMtu) (*PortResult, error)
+	SetFlowControl(context.Context, *PortFlowControl) (*PortResult, error)
+	// Empty interface name returns configuration of all ports
+	GetPortConfig(context.Context, *PortIface) (*PortConfigList, error)
+	// Empty interface name returns status of all ports
+	GetPortStatus(context.Context, *PortIface) (*PortStatusList, error)
+	// Empty interface name streams link events of all ports
+	WatchLinkEvents(*PortIface, PortManagement_WatchLinkEventsServer) error
+	GetPortStats(context.Context, *PortStatsRequest) (*PortStatsList, error)
+	// Empty interface name clears counters of all ports
+	ClearPortStats(context.Context, *PortIface) (*PortResult, error)
+	SetStormControl(context.Context, *PortStormControl) (*PortResult, error)
+	// Empty interface name returns storm control of all ports
+	GetStormControl(context.Context, *PortIface) (*PortStormControlList, error)
+	// Loops frames sent from CPU back on port, port is restored afterwards
+	RunSelfTest(context.Context, *PortSelfTest) (*PortSelfTestResult, error)
+}
+
+// UnimplementedPortManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedPortManagementServer struct {
+}
+
+func (*UnimplementedPortManagementServer) SetAdminState(ctx context.Context, req *PortAdminState) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminState not implemented")
+}
+func (*UnimplementedPortManagementServer) SetSpeed(ctx context.Context, req *PortSpeed) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpeed not implemented")
+}
+func (*UnimplementedPortManagementServer) SetFec(ctx context.Context, req *PortFec) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFec not implemented")
+}
+func (*UnimplementedPortManagementServer) SetAutoneg(ctx context.Context, req *PortAutoneg) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoneg not implemented")
+}
+func (*UnimplementedPortManagementServer) SetMtu(ctx context.Context, req *PortMtu) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMtu not implemented")
+}
+func (*UnimplementedPortManagementServer) SetFlowControl(ctx context.Context, req *PortFlowControl) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlowControl not implemented")
+}
+func (*UnimplementedPortManagementServer) GetPortConfig(ctx context.Context, req *PortIface) (*PortConfigList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortConfig not implemented")
+}
+func (*UnimplementedPortManagementServer) GetPortStatus(ctx context.Context, req *PortIface) (*PortStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortStatus not implemented")
+}
+func (*UnimplementedPortManagementServer) WatchLinkEvents(req *PortIface, srv PortManagement_WatchLinkEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLinkEvents not implemented")
+}
+func (*UnimplementedPortManagementServer) GetPortStats(ctx context.Context, req *PortStatsRequest) (*PortStatsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortStats not implemented")
+}
+func (*UnimplementedPortManagementServer) ClearPortStats(ctx context.Context, req *PortIface) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPortStats not implemented")
+}
+func (*UnimplementedPortManagementServer) SetStormControl(ctx context.Context, req *PortStormControl) (*PortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStormControl not implemented")
+}
+func (*UnimplementedPortManagementServer) GetStormControl(ctx context.Context, req *PortIface) (*PortStormControlList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStormControl not implemented")
+}
+func (*UnimplementedPortManagementServer) RunSelfTest(ctx context.Context, req *PortSelfTest) (*PortSelfTestResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSelfTest not implemented")
+}
+
+func RegisterPortManagementServer(s *grpc.Server, srv PortManagementServer) {
+	s.RegisterService(&_PortManagement_serviceDesc, srv)
+}
+
+func _PortManagement_SetAdminState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortAdminState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetAdminState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetAdminState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetAdminState(ctx, req.(*PortAdminState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetSpeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortSpeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetSpeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetSpeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetSpeed(ctx, req.(*PortSpeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetFec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortFec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetFec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetFec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetFec(ctx, req.(*PortFec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetAutoneg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortAutoneg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetAutoneg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetAutoneg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetAutoneg(ctx, req.(*PortAutoneg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetMtu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortMtu)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetMtu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetMtu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetMtu(ctx, req.(*PortMtu))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetFlowControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortFlowControl)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetFlowControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetFlowControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetFlowControl(ctx, req.(*PortFlowControl))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_GetPortConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).GetPortConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/GetPortConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).GetPortConfig(ctx, req.(*PortIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_GetPortStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).GetPortStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/GetPortStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).GetPortStatus(ctx, req.(*PortIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_WatchLinkEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PortIface)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PortManagementServer).WatchLinkEvents(m, &portManagementWatchLinkEventsServer{stream})
+}
+
+type PortManagement_WatchLinkEventsServer interface {
+	Send(*PortLinkEvent) error
+	grpc.ServerStream
+}
+
+type portManagementWatchLinkEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *portManagementWatchLinkEventsServer) Send(m *PortLinkEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PortManagement_GetPortStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).GetPortStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/GetPortStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).GetPortStats(ctx, req.(*PortStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_ClearPortStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).ClearPortStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/ClearPortStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).ClearPortStats(ctx, req.(*PortIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_SetStormControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortStormControl)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).SetStormControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/SetStormControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).SetStormControl(ctx, req.(*PortStormControl))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_GetStormControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).GetStormControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/GetStormControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).GetStormControl(ctx, req.(*PortIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortManagement_RunSelfTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortSelfTest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortManagementServer).RunSelfTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Port.PortManagement/RunSelfTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortManagementServer).RunSelfTest(ctx, req.(*PortSelfTest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PortManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.Port.PortManagement",
+	HandlerType: (*PortManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAdminState",
+			Handler:    _PortManagement_SetAdminState_Handler,
+		},
+		{
+			MethodName: "SetSpeed",
+			Handler:    _PortManagement_SetSpeed_Handler,
+		},
+		{
+			MethodName: "SetFec",
+			Handler:    _PortManagement_SetFec_Handler,
+		},
+		{
+			MethodName: "SetAutoneg",
+			Handler:    _PortManagement_SetAutoneg_Handler,
+		},
+		{
+			MethodName: "SetMtu",
+			Handler:    _PortManagement_SetMtu_Handler,
+		},
+		{
+			MethodName: "SetFlowControl",
+			Handler:    _PortManagement_SetFlowControl_Handler,
+		},
+		{
+			MethodName: "GetPortConfig",
+			Handler:    _PortManagement_GetPortConfig_Handler,
+		},
+		{
+			MethodName: "GetPortStatus",
+			Handler:    _PortManagement_GetPortStatus_Handler,
+		},
+		{
+			MethodName: "GetPortStats",
+			Handler:    _PortManagement_GetPortStats_Handler,
+		},
+		{
+			MethodName: "ClearPortStats",
+			Handler:    _PortManagement_ClearPortStats_Handler,
+		},
+		{
+			MethodName: "SetStormControl",
+			Handler:    _PortManagement_SetStormControl_Handler,
+		},
+		{
+			MethodName: "GetStormControl",
+			Handler:    _PortManagement_GetStormControl_Handler,
+		},
+		{
+			MethodName: "RunSelfTest",
+			Handler:    _PortManagement_RunSelfTest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLinkEvents",
+			Handler:       _PortManagement_WatchLinkEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "port_management.proto",
+}
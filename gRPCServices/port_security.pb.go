@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: port_security.proto
+
+package OpenNos_Switch_PortSecurity
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PortSecurityConfig_Action int32
+
+const (
+	PortSecurityConfig_DROP         PortSecurityConfig_Action = 0
+	PortSecurityConfig_DROP_AND_LOG PortSecurityConfig_Action = 1
+	PortSecurityConfig_SHUTDOWN     PortSecurityConfig_Action = 2
+)
+
+var PortSecurityConfig_Action_name = map[int32]string{
+	0: "DROP",
+	1: "DROP_AND_LOG",
+	2: "SHUTDOWN",
+}
+
+var PortSecurityConfig_Action_value = map[string]int32{
+	"DROP":         0,
+	"DROP_AND_LOG": 1,
+	"SHUTDOWN":     2,
+}
+
+func (x PortSecurityConfig_Action) String() string {
+	return proto.EnumName(PortSecurityConfig_Action_name, int32(x))
+}
+
+func (PortSecurityConfig_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{1, 0}
+}
+
+type PortSecurityResult_Result int32
+
+const (
+	PortSecurityResult_FAILED  PortSecurityResult_Result = 0
+	PortSecurityResult_SUCCESS PortSecurityResult_Result = 1
+)
+
+var PortSecurityResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var PortSecurityResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x PortSecurityResult_Result) String() string {
+	return proto.EnumName(PortSecurityResult_Result_name, int32(x))
+}
+
+func (PortSecurityResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{4, 0}
+}
+
+type PortSecurityIface struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortSecurityIface) Reset()         { *m = PortSecurityIface{} }
+func (m *PortSecurityIface) String() string { return proto.CompactTextString(m) }
+func (*PortSecurityIface) ProtoMessage()    {}
+func (*PortSecurityIface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{0}
+}
+
+func (m *PortSecurityIface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSecurityIface.Unmarshal(m, b)
+}
+func (m *PortSecurityIface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSecurityIface.Marshal(b, m, deterministic)
+}
+func (m *PortSecurityIface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSecurityIface.Merge(m, src)
+}
+func (m *PortSecurityIface) XXX_Size() int {
+	return xxx_messageInfo_PortSecurityIface.Size(m)
+}
+func (m *PortSecurityIface) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSecurityIface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSecurityIface proto.InternalMessageInfo
+
+func (m *PortSecurityIface) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+type PortSecurityConfig struct {
+	Interface *PortSecurityIface        `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Enabled   bool                      `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxMacs   uint32                    `protobuf:"varint,3,opt,name=maxMacs,proto3" json:"maxMacs,omitempty"`
+	Action    PortSecurityConfig_Action `protobuf:"varint,4,opt,name=action,proto3,enum=OpenNos.Switch.PortSecurity.PortSecurityConfig_Action" json:"action,omitempty"`
+	// Learned addresses become static entries preserved between restarts
+	Sticky bool `protobuf:"varint,5,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	// Period after which port shut down on violation is enabled again. Zero disables auto-recovery.
+	AutoRecoverySec      uint32   `protobuf:"varint,6,opt,name=autoRecoverySec,proto3" json:"autoRecoverySec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortSecurityConfig) Reset()         { *m = PortSecurityConfig{} }
+func (m *PortSecurityConfig) String() string { return proto.CompactTextString(m) }
+func (*PortSecurityConfig) ProtoMessage()    {}
+func (*PortSecurityConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{1}
+}
+
+func (m *PortSecurityConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSecurityConfig.Unmarshal(m, b)
+}
+func (m *PortSecurityConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSecurityConfig.Marshal(b, m, deterministic)
+}
+func (m *PortSecurityConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSecurityConfig.Merge(m, src)
+}
+func (m *PortSecurityConfig) XXX_Size() int {
+	return xxx_messageInfo_PortSecurityConfig.Size(m)
+}
+func (m *PortSecurityConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSecurityConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSecurityConfig proto.InternalMessageInfo
+
+func (m *PortSecurityConfig) GetInterface() *PortSecurityIface {
+	if m != nil {
+		return m.Interface
+	}
+	return nil
+}
+
+func (m *PortSecurityConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PortSecurityConfig) GetMaxMacs() uint32 {
+	if m != nil {
+		return m.MaxMacs
+	}
+	return 0
+}
+
+func (m *PortSecurityConfig) GetAction() PortSecurityConfig_Action {
+	if m != nil {
+		return m.Action
+	}
+	return PortSecurityConfig_DROP
+}
+
+func (m *PortSecurityConfig) GetSticky() bool {
+	if m != nil {
+		return m.Sticky
+	}
+	return false
+}
+
+func (m *PortSecurityConfig) GetAutoRecoverySec() uint32 {
+	if m != nil {
+		return m.AutoRecoverySec
+	}
+	return 0
+}
+
+type PortSecurityStatus struct {
+	Config                 *PortSecurityConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	MacCount               uint32              `protobuf:"varint,2,opt,name=macCount,proto3" json:"macCount,omitempty"`
+	StickyMacCount         uint32              `protobuf:"varint,3,opt,name=stickyMacCount,proto3" json:"stickyMacCount,omitempty"`
+	Violations             uint64              `protobuf:"varint,4,opt,name=violations,proto3" json:"violations,omitempty"`
+	LearningLocked         bool                `protobuf:"varint,5,opt,name=learningLocked,proto3" json:"learningLocked,omitempty"`
+	ErrDisabled            bool                `protobuf:"varint,6,opt,name=errDisabled,proto3" json:"errDisabled,omitempty"`
+	LastViolationMac       string              `protobuf:"bytes,7,opt,name=lastViolationMac,proto3" json:"lastViolationMac,omitempty"`
+	LastViolationTimestamp int64               `protobuf:"varint,8,opt,name=lastViolationTimestamp,proto3" json:"lastViolationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
+	XXX_unrecognized       []byte              `json:"-"`
+	XXX_sizecache          int32               `json:"-"`
+}
+
+func (m *PortSecurityStatus) Reset()         { *m = PortSecurityStatus{} }
+func (m *PortSecurityStatus) String() string { return proto.CompactTextString(m) }
+func (*PortSecurityStatus) ProtoMessage()    {}
+func (*PortSecurityStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{2}
+}
+
+func (m *PortSecurityStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSecurityStatus.Unmarshal(m, b)
+}
+func (m *PortSecurityStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSecurityStatus.Marshal(b, m, deterministic)
+}
+func (m *PortSecurityStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSecurityStatus.Merge(m, src)
+}
+func (m *PortSecurityStatus) XXX_Size() int {
+	return xxx_messageInfo_PortSecurityStatus.Size(m)
+}
+func (m *PortSecurityStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSecurityStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSecurityStatus proto.InternalMessageInfo
+
+func (m *PortSecurityStatus) GetConfig() *PortSecurityConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PortSecurityStatus) GetMacCount() uint32 {
+	if m != nil {
+		return m.MacCount
+	}
+	return 0
+}
+
+func (m *PortSecurityStatus) GetStickyMacCount() uint32 {
+	if m != nil {
+		return m.StickyMacCount
+	}
+	return 0
+}
+
+func (m *PortSecurityStatus) GetViolations() uint64 {
+	if m != nil {
+		return m.Violations
+	}
+	return 0
+}
+
+func (m *PortSecurityStatus) GetLearningLocked() bool {
+	if m != nil {
+		return m.LearningLocked
+	}
+	return false
+}
+
+func (m *PortSecurityStatus) GetErrDisabled() bool {
+	if m != nil {
+		return m.ErrDisabled
+	}
+	return false
+}
+
+func (m *PortSecurityStatus) GetLastViolationMac() string {
+	if m != nil {
+		return m.LastViolationMac
+	}
+	return ""
+}
+
+func (m *PortSecurityStatus) GetLastViolationTimestamp() int64 {
+	if m != nil {
+		return m.LastViolationTimestamp
+	}
+	return 0
+}
+
+type PortSecurityStatusList struct {
+	Statuses             []*PortSecurityStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PortSecurityStatusList) Reset()         { *m = PortSecurityStatusList{} }
+func (m *PortSecurityStatusList) String() string { return proto.CompactTextString(m) }
+func (*PortSecurityStatusList) ProtoMessage()    {}
+func (*PortSecurityStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{3}
+}
+
+func (m *PortSecurityStatusList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSecurityStatusList.Unmarshal(m, b)
+}
+func (m *PortSecurityStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSecurityStatusList.Marshal(b, m, deterministic)
+}
+func (m *PortSecurityStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSecurityStatusList.Merge(m, src)
+}
+func (m *PortSecurityStatusList) XXX_Size() int {
+	return xxx_messageInfo_PortSecurityStatusList.Size(m)
+}
+func (m *PortSecurityStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSecurityStatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSecurityStatusList proto.InternalMessageInfo
+
+func (m *PortSecurityStatusList) GetStatuses() []*PortSecurityStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type PortSecurityResult struct {
+	Result               PortSecurityResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.PortSecurity.PortSecurityResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PortSecurityResult) Reset()         { *m = PortSecurityResult{} }
+func (m *PortSecurityResult) String() string { return proto.CompactTextString(m) }
+func (*PortSecurityResult) ProtoMessage()    {}
+func (*PortSecurityResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300f0a77cb37035c, []int{4}
+}
+
+func (m *PortSecurityResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortSecurityResult.Unmarshal(m, b)
+}
+func (m *PortSecurityResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortSecurityResult.Marshal(b, m, deterministic)
+}
+func (m *PortSecurityResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSecurityResult.Merge(m, src)
+}
+func (m *PortSecurityResult) XXX_Size() int {
+	return xxx_messageInfo_PortSecurityResult.Size(m)
+}
+func (m *PortSecurityResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSecurityResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSecurityResult proto.InternalMessageInfo
+
+func (m *PortSecurityResult) GetResult() PortSecurityResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return PortSecurityResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.PortSecurity.PortSecurityConfig_Action", PortSecurityConfig_Action_name, PortSecurityConfig_Action_value)
+	proto.RegisterEnum("OpenNos.Switch.PortSecurity.PortSecurityResult_Result", PortSecurityResult_Result_name, PortSecurityResult_Result_value)
+	proto.RegisterType((*PortSecurityIface)(nil), "OpenNos.Switch.PortSecurity.PortSecurityIface")
+	proto.RegisterType((*PortSecurityConfig)(nil), "OpenNos.Switch.PortSecurity.PortSecurityConfig")
+	proto.RegisterType((*PortSecurityStatus)(nil), "OpenNos.Switch.PortSecurity.PortSecurityStatus")
+	proto.RegisterType((*PortSecurityStatusList)(nil), "OpenNos.Switch.PortSecurity.PortSecurityStatusList")
+	proto.RegisterType((*PortSecurityResult)(nil), "OpenNos.Switch.PortSecurity.PortSecurityResult")
+}
+
+func init() { proto.RegisterFile("port_security.proto", fileDescriptor_300f0a77cb37035c) }
+
+var fileDescriptor_300f0a77cb37035c = []byte{
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0xdb, 0x4c,
+	0x10, 0xb5, 0x9c, 0x7c, 0xb2, 0x32, 0x76, 0x62, 0x7d, 0x5b, 0x30, 0x8b, 0x0b, 0x45, 0xd5, 0x45,
+	0x11, 0x2d, 0x28, 0xe0, 0x40, 0xee, 0x8d, 0x9d, 0xba, 0xa1, 0xfe, 0x09, 0xab, 0xa4, 0xbd, 0x34,
+	0x9b, 0xcd, 0x3a, 0x5d, 0x62, 0x6b, 0x8d, 0x76, 0x95, 0xc6, 0x4f, 0xd0, 0x97, 0xec, 0x3b, 0xf4,
+	0xaa, 0xf7, 0x45, 0x2b, 0x39, 0xf5, 0x0f, 0x2d, 0xd5, 0x95, 0x74, 0x8e, 0x66, 0xce, 0xcc, 0x9c,
+	0x19, 0x04, 0x2f, 0x96, 0x32, 0xd1, 0x53, 0xc5, 0x59, 0x9a, 0x08, 0xbd, 0x0a, 0x97, 0x89, 0xd4,
+	0x12, 0xbd, 0x9c, 0x2c, 0x79, 0x3c, 0x96, 0x2a, 0x8c, 0xbe, 0x0a, 0xcd, 0xbe, 0x84, 0x57, 0x32,
+	0xd1, 0x51, 0x11, 0xe2, 0xbf, 0x83, 0xff, 0x37, 0xf1, 0xe5, 0x8c, 0x32, 0x8e, 0x5a, 0x60, 0x8b,
+	0x59, 0x4c, 0x17, 0x1c, 0x5b, 0x9e, 0x15, 0x1c, 0x91, 0x02, 0xf9, 0xdf, 0xab, 0x80, 0x36, 0xa3,
+	0x7b, 0x32, 0x9e, 0x89, 0x7b, 0x34, 0x84, 0x23, 0x11, 0x6b, 0x9e, 0x64, 0xb9, 0x26, 0xa3, 0xde,
+	0x09, 0xc3, 0xbf, 0x14, 0x0d, 0xf7, 0x2a, 0x92, 0xdf, 0x02, 0x08, 0x43, 0x8d, 0xc7, 0xf4, 0x76,
+	0xce, 0xef, 0x70, 0xd5, 0xb3, 0x02, 0x87, 0xac, 0x61, 0xf6, 0x65, 0x41, 0x9f, 0x46, 0x94, 0x29,
+	0x7c, 0xe0, 0x59, 0xc1, 0x31, 0x59, 0x43, 0x34, 0x06, 0x9b, 0x32, 0x2d, 0x64, 0x8c, 0x0f, 0x3d,
+	0x2b, 0x38, 0xe9, 0x9c, 0xff, 0x73, 0xf9, 0x7c, 0x84, 0xb0, 0x6b, 0xb2, 0x49, 0xa1, 0x92, 0x19,
+	0xa0, 0xb4, 0x60, 0x0f, 0x2b, 0xfc, 0x9f, 0x69, 0xa1, 0x40, 0x28, 0x80, 0x26, 0x4d, 0xb5, 0x24,
+	0x9c, 0xc9, 0x47, 0x9e, 0xac, 0x22, 0xce, 0xb0, 0x6d, 0x3a, 0xd9, 0xa5, 0xfd, 0x0e, 0xd8, 0xb9,
+	0x26, 0x72, 0xe0, 0xb0, 0x4f, 0x26, 0x57, 0x6e, 0x05, 0xb9, 0xd0, 0xc8, 0xde, 0xa6, 0xdd, 0x71,
+	0x7f, 0x3a, 0x9c, 0x0c, 0x5c, 0x0b, 0x35, 0xc0, 0x89, 0x3e, 0xdc, 0x5c, 0xf7, 0x27, 0x9f, 0xc7,
+	0x6e, 0xd5, 0xff, 0xb1, 0x63, 0x6f, 0xa4, 0xa9, 0x4e, 0x15, 0x1a, 0x80, 0xcd, 0x4c, 0x97, 0x85,
+	0xb7, 0xa7, 0x25, 0x87, 0x23, 0x45, 0x3a, 0x6a, 0x83, 0xb3, 0xa0, 0xac, 0x27, 0xd3, 0x58, 0x1b,
+	0x6b, 0x8f, 0xc9, 0x33, 0x46, 0x6f, 0xe0, 0x24, 0x9f, 0x71, 0xb4, 0x8e, 0xc8, 0x2d, 0xde, 0x61,
+	0xd1, 0x2b, 0x80, 0x47, 0x21, 0xe7, 0x34, 0x1b, 0x4d, 0x19, 0xb7, 0x0f, 0xc9, 0x06, 0x93, 0xe9,
+	0xcc, 0x39, 0x4d, 0x62, 0x11, 0xdf, 0x0f, 0x25, 0x7b, 0xe0, 0x77, 0x85, 0x83, 0x3b, 0x2c, 0xf2,
+	0xa0, 0xce, 0x93, 0xa4, 0x2f, 0x54, 0xbe, 0x69, 0xdb, 0x04, 0x6d, 0x52, 0xe8, 0x2d, 0xb8, 0x73,
+	0xaa, 0xf4, 0xa7, 0xb5, 0xf6, 0x88, 0x32, 0x5c, 0x33, 0xe7, 0xb8, 0xc7, 0xa3, 0x73, 0x68, 0x6d,
+	0x71, 0xd7, 0x62, 0xc1, 0x95, 0xa6, 0x8b, 0x25, 0x76, 0x3c, 0x2b, 0x38, 0x20, 0x7f, 0xf8, 0xea,
+	0x73, 0x68, 0xed, 0x1b, 0x3e, 0x14, 0x4a, 0xa3, 0x8f, 0xe0, 0x28, 0x83, 0xb8, 0xc2, 0x96, 0x77,
+	0x50, 0xca, 0xf6, 0x5c, 0x86, 0x3c, 0x0b, 0xf8, 0xdf, 0xac, 0xed, 0xc5, 0x12, 0xae, 0xd2, 0xb9,
+	0xce, 0xae, 0x36, 0x31, 0x6f, 0xd8, 0x2a, 0x79, 0xb5, 0xb9, 0x40, 0x98, 0x3f, 0x48, 0xa1, 0xe2,
+	0xbf, 0x06, 0xbb, 0x50, 0x06, 0xb0, 0xdf, 0x77, 0x2f, 0x87, 0x17, 0x7d, 0xb7, 0x82, 0xea, 0x50,
+	0x8b, 0x6e, 0x7a, 0xbd, 0x8b, 0x28, 0x72, 0xad, 0xce, 0xcf, 0x2a, 0x34, 0x36, 0x85, 0x50, 0x0a,
+	0xcd, 0x88, 0xeb, 0x2d, 0xaa, 0xec, 0x7d, 0xb5, 0x4f, 0x4b, 0xf6, 0xed, 0x57, 0xd0, 0x13, 0x34,
+	0x07, 0x3b, 0x65, 0x4b, 0xfe, 0x32, 0xda, 0x67, 0x25, 0xf7, 0x91, 0xad, 0xd5, 0xaf, 0x20, 0x0d,
+	0xcd, 0x5e, 0x76, 0x8b, 0xd1, 0xfa, 0xae, 0x55, 0xe9, 0xca, 0xe5, 0xe7, 0xbd, 0xb5, 0xcd, 0xaf,
+	0xf8, 0xec, 0xd7, 0x00, 0xe6, 0x08, 0x02, 0x79, 0xa1, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PortSecurityClient is the client API for PortSecurity service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PortSecurityClient interface {
+	SetPortSecurity(ctx context.Context, in *PortSecurityConfig, opts ...grpc.CallOption) (*PortSecurityResult, error)
+	// Empty interface name returns status of all ports with port security enabled
+	GetPortSecurity(ctx context.Context, in *PortSecurityIface, opts ...grpc.CallOption) (*PortSecurityStatusList, error)
+	ClearStickyMacs(ctx context.Context, in *PortSecurityIface, opts ...grpc.CallOption) (*PortSecurityResult, error)
+}
+
+type portSecurityClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPortSecurityClient(cc *grpc.ClientConn) PortSecurityClient {
+	return &portSecurityClient{cc}
+}
+
+func (c *portSecurityClient) SetPortSecurity(ctx context.Context, in *PortSecurityConfig, opts ...grpc.CallOption) (*PortSecurityResult, error) {
+	out := new(PortSecurityResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.PortSecurity.PortSecurity/SetPortSecurity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portSecurityClient) GetPortSecurity(ctx context.Context, in *PortSecurityIface, opts ...grpc.CallOption) (*PortSecurityStatusList, error) {
+	out := new(PortSecurityStatusList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.PortSecurity.PortSecurity/GetPortSecurity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portSecurityClient) ClearStickyMacs(ctx context.Context, in *PortSecurityIface, opts ...grpc.CallOption) (*PortSecurityResult, error) {
+	out := new(PortSecurityResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.PortSecurity.PortSecurity/ClearStickyMacs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PortSecurityServer is the server API for PortSecurity service.
+type PortSecurityServer interface {
+	SetPortSecurity(context.Context, *PortSecurityConfig) (*PortSecurityResult, error)
+	// Empty interface name returns status of all ports with port security enabled
+	GetPortSecurity(context.Context, *PortSecurityIface) (*PortSecurityStatusList, error)
+	ClearStickyMacs(context.Context, *PortSecurityIface) (*PortSecurityResult, error)
+}
+
+// UnimplementedPortSecurityServer can be embedded to have forward compatible implementations.
+type UnimplementedPortSecurityServer struct {
+}
+
+func (*UnimplementedPortSecurityServer) SetPortSecurity(ctx context.Context, req *PortSecurityConfig) (*PortSecurityResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPortSecurity not implemented")
+}
+func (*UnimplementedPortSecurityServer) GetPortSecurity(ctx context.Context, req *PortSecurityIface) (*PortSecurityStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortSecurity not implemented")
+}
+func (*UnimplementedPortSecurityServer) ClearStickyMacs(ctx context.Context, req *PortSecurityIface) (*PortSecurityResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearStickyMacs not implemented")
+}
+
+func RegisterPortSecurityServer(s *grpc.Server, srv PortSecurityServer) {
+	s.RegisterService(&_PortSecurity_serviceDesc, srv)
+}
+
+func _PortSecurity_SetPortSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortSecurityConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortSecurityServer).SetPortSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.PortSecurity.PortSecurity/SetPortSecurity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortSecurityServer).SetPortSecurity(ctx, req.(*PortSecurityConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortSecurity_GetPortSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortSecurityIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortSecurityServer).GetPortSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.PortSecurity.PortSecurity/GetPortSecurity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortSecurityServer).GetPortSecurity(ctx, req.(*PortSecurityIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortSecurity_ClearStickyMacs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortSecurityIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortSecurityServer).ClearStickyMacs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.PortSecurity.PortSecurity/ClearStickyMacs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortSecurityServer).ClearStickyMacs(ctx, req.(*PortSecurityIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PortSecurity_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.PortSecurity.PortSecurity",
+	HandlerType: (*PortSecurityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPortSecurity",
+			Handler:    _PortSecurity_SetPortSecurity_Handler,
+		},
+		{
+			MethodName: "GetPortSecurity",
+			Handler:    _PortSecurity_GetPortSecurity_Handler,
+		},
+		{
+			MethodName: "ClearStickyMacs",
+			Handler:    _PortSecurity_ClearStickyMacs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "port_security.proto",
+}
@@ -58,6 +58,34 @@ func (StpState_State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0cd0a974678078f1, []int{1, 0}
 }
 
+type StpLearningPolicy_Mode int32
+
+const (
+	StpLearningPolicy_FOLLOW_STP      StpLearningPolicy_Mode = 0
+	StpLearningPolicy_ALWAYS_ENABLED  StpLearningPolicy_Mode = 1
+	StpLearningPolicy_ALWAYS_DISABLED StpLearningPolicy_Mode = 2
+)
+
+var StpLearningPolicy_Mode_name = map[int32]string{
+	0: "FOLLOW_STP",
+	1: "ALWAYS_ENABLED",
+	2: "ALWAYS_DISABLED",
+}
+
+var StpLearningPolicy_Mode_value = map[string]int32{
+	"FOLLOW_STP":      0,
+	"ALWAYS_ENABLED":  1,
+	"ALWAYS_DISABLED": 2,
+}
+
+func (x StpLearningPolicy_Mode) String() string {
+	return proto.EnumName(StpLearningPolicy_Mode_name, int32(x))
+}
+
+func (StpLearningPolicy_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{7, 0}
+}
+
 type StpResult_Result int32
 
 const (
@@ -80,7 +108,7 @@ func (x StpResult_Result) String() string {
 }
 
 func (StpResult_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0cd0a974678078f1, []int{3, 0}
+	return fileDescriptor_0cd0a974678078f1, []int{8, 0}
 }
 
 type StpInterface struct {
@@ -125,6 +153,7 @@ func (m *StpInterface) GetIfname() string {
 type StpState struct {
 	Interface            *StpInterface  `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
 	State                StpState_State `protobuf:"varint,2,opt,name=state,proto3,enum=OpenNos.Plugin.Stp.StpState_State" json:"state,omitempty"`
+	Instance             uint32         `protobuf:"varint,3,opt,name=instance,proto3" json:"instance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -169,6 +198,60 @@ func (m *StpState) GetState() StpState_State {
 	return StpState_DISABLED
 }
 
+func (m *StpState) GetInstance() uint32 {
+	if m != nil {
+		return m.Instance
+	}
+	return 0
+}
+
+type StpStateBatch struct {
+	States               []*StpState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	RollbackOnFailure    bool        `protobuf:"varint,2,opt,name=rollbackOnFailure,proto3" json:"rollbackOnFailure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StpStateBatch) Reset()         { *m = StpStateBatch{} }
+func (m *StpStateBatch) String() string { return proto.CompactTextString(m) }
+func (*StpStateBatch) ProtoMessage()    {}
+func (*StpStateBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{2}
+}
+
+func (m *StpStateBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpStateBatch.Unmarshal(m, b)
+}
+func (m *StpStateBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpStateBatch.Marshal(b, m, deterministic)
+}
+func (m *StpStateBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpStateBatch.Merge(m, src)
+}
+func (m *StpStateBatch) XXX_Size() int {
+	return xxx_messageInfo_StpStateBatch.Size(m)
+}
+func (m *StpStateBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpStateBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpStateBatch proto.InternalMessageInfo
+
+func (m *StpStateBatch) GetStates() []*StpState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *StpStateBatch) GetRollbackOnFailure() bool {
+	if m != nil {
+		return m.RollbackOnFailure
+	}
+	return false
+}
+
 type StpAgeingTime struct {
 	AgeingTime           uint32   `protobuf:"varint,1,opt,name=ageingTime,proto3" json:"ageingTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -180,7 +263,7 @@ func (m *StpAgeingTime) Reset()         { *m = StpAgeingTime{} }
 func (m *StpAgeingTime) String() string { return proto.CompactTextString(m) }
 func (*StpAgeingTime) ProtoMessage()    {}
 func (*StpAgeingTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0cd0a974678078f1, []int{2}
+	return fileDescriptor_0cd0a974678078f1, []int{3}
 }
 
 func (m *StpAgeingTime) XXX_Unmarshal(b []byte) error {
@@ -208,6 +291,204 @@ func (m *StpAgeingTime) GetAgeingTime() uint32 {
 	return 0
 }
 
+type StpFastAgeing struct {
+	AgeingTime           uint32   `protobuf:"varint,1,opt,name=ageingTime,proto3" json:"ageingTime,omitempty"`
+	DurationSec          uint32   `protobuf:"varint,2,opt,name=durationSec,proto3" json:"durationSec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StpFastAgeing) Reset()         { *m = StpFastAgeing{} }
+func (m *StpFastAgeing) String() string { return proto.CompactTextString(m) }
+func (*StpFastAgeing) ProtoMessage()    {}
+func (*StpFastAgeing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{4}
+}
+
+func (m *StpFastAgeing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpFastAgeing.Unmarshal(m, b)
+}
+func (m *StpFastAgeing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpFastAgeing.Marshal(b, m, deterministic)
+}
+func (m *StpFastAgeing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpFastAgeing.Merge(m, src)
+}
+func (m *StpFastAgeing) XXX_Size() int {
+	return xxx_messageInfo_StpFastAgeing.Size(m)
+}
+func (m *StpFastAgeing) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpFastAgeing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpFastAgeing proto.InternalMessageInfo
+
+func (m *StpFastAgeing) GetAgeingTime() uint32 {
+	if m != nil {
+		return m.AgeingTime
+	}
+	return 0
+}
+
+func (m *StpFastAgeing) GetDurationSec() uint32 {
+	if m != nil {
+		return m.DurationSec
+	}
+	return 0
+}
+
+type StpAgeingState struct {
+	// Ageing time set by SetAgeingTime, zero means ageing is disabled
+	AgeingTime uint32 `protobuf:"varint,1,opt,name=ageingTime,proto3" json:"ageingTime,omitempty"`
+	// Ageing time programmed in hardware, differs from ageingTime during fast ageing
+	ActiveAgeingTime       uint32   `protobuf:"varint,2,opt,name=activeAgeingTime,proto3" json:"activeAgeingTime,omitempty"`
+	FastAgeing             bool     `protobuf:"varint,3,opt,name=fastAgeing,proto3" json:"fastAgeing,omitempty"`
+	FastAgeingRemainingSec uint32   `protobuf:"varint,4,opt,name=fastAgeingRemainingSec,proto3" json:"fastAgeingRemainingSec,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *StpAgeingState) Reset()         { *m = StpAgeingState{} }
+func (m *StpAgeingState) String() string { return proto.CompactTextString(m) }
+func (*StpAgeingState) ProtoMessage()    {}
+func (*StpAgeingState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{5}
+}
+
+func (m *StpAgeingState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpAgeingState.Unmarshal(m, b)
+}
+func (m *StpAgeingState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpAgeingState.Marshal(b, m, deterministic)
+}
+func (m *StpAgeingState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpAgeingState.Merge(m, src)
+}
+func (m *StpAgeingState) XXX_Size() int {
+	return xxx_messageInfo_StpAgeingState.Size(m)
+}
+func (m *StpAgeingState) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpAgeingState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpAgeingState proto.InternalMessageInfo
+
+func (m *StpAgeingState) GetAgeingTime() uint32 {
+	if m != nil {
+		return m.AgeingTime
+	}
+	return 0
+}
+
+func (m *StpAgeingState) GetActiveAgeingTime() uint32 {
+	if m != nil {
+		return m.ActiveAgeingTime
+	}
+	return 0
+}
+
+func (m *StpAgeingState) GetFastAgeing() bool {
+	if m != nil {
+		return m.FastAgeing
+	}
+	return false
+}
+
+func (m *StpAgeingState) GetFastAgeingRemainingSec() uint32 {
+	if m != nil {
+		return m.FastAgeingRemainingSec
+	}
+	return 0
+}
+
+type StpEmpty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StpEmpty) Reset()         { *m = StpEmpty{} }
+func (m *StpEmpty) String() string { return proto.CompactTextString(m) }
+func (*StpEmpty) ProtoMessage()    {}
+func (*StpEmpty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{6}
+}
+
+func (m *StpEmpty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpEmpty.Unmarshal(m, b)
+}
+func (m *StpEmpty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpEmpty.Marshal(b, m, deterministic)
+}
+func (m *StpEmpty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpEmpty.Merge(m, src)
+}
+func (m *StpEmpty) XXX_Size() int {
+	return xxx_messageInfo_StpEmpty.Size(m)
+}
+func (m *StpEmpty) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpEmpty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpEmpty proto.InternalMessageInfo
+
+type StpLearningPolicy struct {
+	Interface            *StpInterface          `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Mode                 StpLearningPolicy_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=OpenNos.Plugin.Stp.StpLearningPolicy_Mode" json:"mode,omitempty"`
+	FlushOnBlock         bool                   `protobuf:"varint,3,opt,name=flushOnBlock,proto3" json:"flushOnBlock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *StpLearningPolicy) Reset()         { *m = StpLearningPolicy{} }
+func (m *StpLearningPolicy) String() string { return proto.CompactTextString(m) }
+func (*StpLearningPolicy) ProtoMessage()    {}
+func (*StpLearningPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{7}
+}
+
+func (m *StpLearningPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpLearningPolicy.Unmarshal(m, b)
+}
+func (m *StpLearningPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpLearningPolicy.Marshal(b, m, deterministic)
+}
+func (m *StpLearningPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpLearningPolicy.Merge(m, src)
+}
+func (m *StpLearningPolicy) XXX_Size() int {
+	return xxx_messageInfo_StpLearningPolicy.Size(m)
+}
+func (m *StpLearningPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpLearningPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpLearningPolicy proto.InternalMessageInfo
+
+func (m *StpLearningPolicy) GetInterface() *StpInterface {
+	if m != nil {
+		return m.Interface
+	}
+	return nil
+}
+
+func (m *StpLearningPolicy) GetMode() StpLearningPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return StpLearningPolicy_FOLLOW_STP
+}
+
+func (m *StpLearningPolicy) GetFlushOnBlock() bool {
+	if m != nil {
+		return m.FlushOnBlock
+	}
+	return false
+}
+
 type StpResult struct {
 	Result               StpResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Plugin.Stp.StpResult_Result" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -219,7 +500,7 @@ func (m *StpResult) Reset()         { *m = StpResult{} }
 func (m *StpResult) String() string { return proto.CompactTextString(m) }
 func (*StpResult) ProtoMessage()    {}
 func (*StpResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0cd0a974678078f1, []int{3}
+	return fileDescriptor_0cd0a974678078f1, []int{8}
 }
 
 func (m *StpResult) XXX_Unmarshal(b []byte) error {
@@ -247,43 +528,195 @@ func (m *StpResult) GetResult() StpResult_Result {
 	return StpResult_FAILED
 }
 
+type StpStateResult struct {
+	State                *StpState        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Result               StpResult_Result `protobuf:"varint,2,opt,name=result,proto3,enum=OpenNos.Plugin.Stp.StpResult_Result" json:"result,omitempty"`
+	Error                string           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StpStateResult) Reset()         { *m = StpStateResult{} }
+func (m *StpStateResult) String() string { return proto.CompactTextString(m) }
+func (*StpStateResult) ProtoMessage()    {}
+func (*StpStateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{9}
+}
+
+func (m *StpStateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpStateResult.Unmarshal(m, b)
+}
+func (m *StpStateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpStateResult.Marshal(b, m, deterministic)
+}
+func (m *StpStateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpStateResult.Merge(m, src)
+}
+func (m *StpStateResult) XXX_Size() int {
+	return xxx_messageInfo_StpStateResult.Size(m)
+}
+func (m *StpStateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpStateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpStateResult proto.InternalMessageInfo
+
+func (m *StpStateResult) GetState() *StpState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *StpStateResult) GetResult() StpResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return StpResult_FAILED
+}
+
+func (m *StpStateResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type StpBatchResult struct {
+	Result     StpResult_Result  `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Plugin.Stp.StpResult_Result" json:"result,omitempty"`
+	Results    []*StpStateResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	RolledBack bool              `protobuf:"varint,3,opt,name=rolledBack,proto3" json:"rolledBack,omitempty"`
+	// Reason of failure of the whole batch, empty if it is described by results only
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StpBatchResult) Reset()         { *m = StpBatchResult{} }
+func (m *StpBatchResult) String() string { return proto.CompactTextString(m) }
+func (*StpBatchResult) ProtoMessage()    {}
+func (*StpBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cd0a974678078f1, []int{10}
+}
+
+func (m *StpBatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StpBatchResult.Unmarshal(m, b)
+}
+func (m *StpBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StpBatchResult.Marshal(b, m, deterministic)
+}
+func (m *StpBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StpBatchResult.Merge(m, src)
+}
+func (m *StpBatchResult) XXX_Size() int {
+	return xxx_messageInfo_StpBatchResult.Size(m)
+}
+func (m *StpBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StpBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StpBatchResult proto.InternalMessageInfo
+
+func (m *StpBatchResult) GetResult() StpResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return StpResult_FAILED
+}
+
+func (m *StpBatchResult) GetResults() []*StpStateResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *StpBatchResult) GetRolledBack() bool {
+	if m != nil {
+		return m.RolledBack
+	}
+	return false
+}
+
+func (m *StpBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("OpenNos.Plugin.Stp.StpState_State", StpState_State_name, StpState_State_value)
+	proto.RegisterEnum("OpenNos.Plugin.Stp.StpLearningPolicy_Mode", StpLearningPolicy_Mode_name, StpLearningPolicy_Mode_value)
 	proto.RegisterEnum("OpenNos.Plugin.Stp.StpResult_Result", StpResult_Result_name, StpResult_Result_value)
 	proto.RegisterType((*StpInterface)(nil), "OpenNos.Plugin.Stp.StpInterface")
 	proto.RegisterType((*StpState)(nil), "OpenNos.Plugin.Stp.StpState")
+	proto.RegisterType((*StpStateBatch)(nil), "OpenNos.Plugin.Stp.StpStateBatch")
 	proto.RegisterType((*StpAgeingTime)(nil), "OpenNos.Plugin.Stp.StpAgeingTime")
+	proto.RegisterType((*StpFastAgeing)(nil), "OpenNos.Plugin.Stp.StpFastAgeing")
+	proto.RegisterType((*StpAgeingState)(nil), "OpenNos.Plugin.Stp.StpAgeingState")
+	proto.RegisterType((*StpEmpty)(nil), "OpenNos.Plugin.Stp.StpEmpty")
+	proto.RegisterType((*StpLearningPolicy)(nil), "OpenNos.Plugin.Stp.StpLearningPolicy")
 	proto.RegisterType((*StpResult)(nil), "OpenNos.Plugin.Stp.StpResult")
+	proto.RegisterType((*StpStateResult)(nil), "OpenNos.Plugin.Stp.StpStateResult")
+	proto.RegisterType((*StpBatchResult)(nil), "OpenNos.Plugin.Stp.StpBatchResult")
 }
 
 func init() { proto.RegisterFile("stp_management.proto", fileDescriptor_0cd0a974678078f1) }
 
 var fileDescriptor_0cd0a974678078f1 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xdf, 0x6b, 0xea, 0x30,
-	0x18, 0xb5, 0xde, 0x6b, 0xaf, 0xfd, 0xb4, 0xe2, 0x0d, 0x63, 0xc8, 0xd8, 0x86, 0x86, 0x31, 0x7c,
-	0xea, 0xa0, 0x7b, 0xd9, 0xc3, 0x18, 0x54, 0x6d, 0x47, 0x59, 0xfd, 0x41, 0xe2, 0xd8, 0xe3, 0xa8,
-	0x5b, 0xec, 0x0a, 0x6d, 0x0c, 0x36, 0xfe, 0x0d, 0xfb, 0x0f, 0xf7, 0xf7, 0x8c, 0xa6, 0x4e, 0x85,
-	0xa1, 0xbe, 0xb4, 0x39, 0xc9, 0x39, 0x27, 0xdf, 0x77, 0xbe, 0xc0, 0x49, 0x26, 0xc5, 0x6b, 0x1a,
-	0xf2, 0x30, 0x62, 0x29, 0xe3, 0xd2, 0x12, 0xcb, 0x85, 0x5c, 0x20, 0x34, 0x16, 0x8c, 0x8f, 0x16,
-	0x99, 0x35, 0x49, 0x56, 0x51, 0xcc, 0x2d, 0x2a, 0x05, 0xbe, 0x86, 0x3a, 0x95, 0xc2, 0xe7, 0x92,
-	0x2d, 0xe7, 0xe1, 0x1b, 0x43, 0xa7, 0xa0, 0xc7, 0x73, 0x1e, 0xa6, 0xac, 0xa5, 0xb5, 0xb5, 0xae,
-	0x41, 0xd6, 0x08, 0x7f, 0x69, 0x50, 0xa5, 0x52, 0x50, 0x19, 0x4a, 0x86, 0x1e, 0xc0, 0x88, 0x7f,
-	0x14, 0x8a, 0x57, 0xb3, 0xdb, 0xd6, 0x6f, 0x73, 0x6b, 0xd7, 0x99, 0x6c, 0x25, 0xe8, 0x0e, 0x2a,
-	0x59, 0x6e, 0xd4, 0x2a, 0xb7, 0xb5, 0x6e, 0xc3, 0xc6, 0x7b, 0xb4, 0xea, 0x32, 0x4b, 0x7d, 0x49,
-	0x21, 0xc0, 0x13, 0xa8, 0x14, 0x25, 0xd4, 0xa1, 0x3a, 0xf0, 0xa9, 0xd3, 0x0b, 0xdc, 0x41, 0xb3,
-	0x94, 0xa3, 0x5e, 0x30, 0xee, 0x3f, 0xf9, 0xa3, 0xc7, 0xa6, 0x86, 0x4c, 0x30, 0x02, 0x9f, 0x4e,
-	0xdd, 0x51, 0x0e, 0xcb, 0xf9, 0x61, 0xe0, 0x3a, 0x44, 0xa1, 0x3f, 0xa8, 0x01, 0xe0, 0x8d, 0xc9,
-	0x8b, 0x43, 0x06, 0x39, 0xfe, 0x8b, 0x6f, 0xc0, 0xa4, 0x52, 0x38, 0x11, 0x8b, 0x79, 0x34, 0x8d,
-	0x53, 0x86, 0x2e, 0x01, 0xc2, 0x0d, 0x52, 0xdd, 0x99, 0x64, 0x67, 0x07, 0x27, 0x60, 0x50, 0x29,
-	0x08, 0xcb, 0x56, 0x89, 0x44, 0xf7, 0xa0, 0x2f, 0xd5, 0x4a, 0x11, 0x1b, 0xf6, 0xd5, 0x9e, 0x56,
-	0x0a, 0xba, 0x55, 0xfc, 0xc8, 0x5a, 0x83, 0x3b, 0xa0, 0xaf, 0x7d, 0x00, 0x74, 0xcf, 0xf1, 0x8b,
-	0x66, 0x6a, 0xf0, 0x8f, 0x3e, 0xf7, 0xfb, 0x2e, 0xa5, 0x4d, 0xcd, 0xfe, 0x2c, 0xab, 0xfa, 0x86,
-	0x9b, 0x59, 0x22, 0x02, 0xff, 0x29, 0x93, 0x9b, 0x5c, 0x8b, 0x38, 0xce, 0x0f, 0x45, 0x78, 0x76,
-	0x71, 0xb0, 0x2a, 0x5c, 0x42, 0x43, 0xa8, 0x7a, 0xc9, 0x2a, 0xfb, 0xf0, 0xde, 0x67, 0xe8, 0xe8,
-	0x24, 0x8f, 0xdb, 0x51, 0x30, 0x29, 0x93, 0x3b, 0x99, 0x76, 0xf6, 0x28, 0xb6, 0x94, 0xa3, 0xa6,
-	0x33, 0x5d, 0x3d, 0xe2, 0xdb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x66, 0x57, 0x65, 0xdc,
-	0x02, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xd1, 0x6e, 0xea, 0x46,
+	0x10, 0xc5, 0x04, 0x08, 0x0c, 0x81, 0xc0, 0x36, 0x8a, 0x50, 0xd4, 0x56, 0x64, 0xd5, 0x56, 0x28,
+	0xaa, 0x5c, 0x89, 0x56, 0x55, 0x1f, 0xa2, 0x54, 0x90, 0x40, 0x84, 0xea, 0x00, 0xdd, 0x4d, 0x14,
+	0x55, 0x55, 0x1b, 0x6d, 0xcc, 0x42, 0xac, 0x18, 0xdb, 0xb2, 0x97, 0x4a, 0xf9, 0x93, 0x7e, 0x48,
+	0xbf, 0xa0, 0x3f, 0xd0, 0x7f, 0xe9, 0x53, 0x1f, 0x2b, 0xef, 0x1a, 0xdb, 0xb9, 0xc8, 0x90, 0xab,
+	0x7b, 0x5f, 0xc0, 0xb3, 0xbb, 0xe7, 0xec, 0x99, 0xf1, 0x99, 0x01, 0x38, 0x0a, 0x84, 0xf7, 0xb0,
+	0x64, 0x0e, 0x5b, 0xf0, 0x25, 0x77, 0x84, 0xee, 0xf9, 0xae, 0x70, 0x11, 0x9a, 0x78, 0xdc, 0x19,
+	0xbb, 0x81, 0x3e, 0xb5, 0x57, 0x0b, 0xcb, 0xd1, 0xa9, 0xf0, 0xf0, 0x57, 0x70, 0x40, 0x85, 0x37,
+	0x72, 0x04, 0xf7, 0xe7, 0xcc, 0xe4, 0xe8, 0x18, 0x4a, 0xd6, 0xdc, 0x61, 0x4b, 0xde, 0xd2, 0xda,
+	0x5a, 0xa7, 0x42, 0xa2, 0x08, 0xff, 0xab, 0x41, 0x99, 0x0a, 0x8f, 0x0a, 0x26, 0x38, 0xba, 0x80,
+	0x8a, 0xb5, 0x46, 0xc8, 0x73, 0xd5, 0x6e, 0x5b, 0xdf, 0x24, 0xd7, 0xd3, 0xcc, 0x24, 0x81, 0xa0,
+	0x1f, 0xa0, 0x18, 0x84, 0x44, 0xad, 0x7c, 0x5b, 0xeb, 0xd4, 0xbb, 0x38, 0x03, 0x2b, 0x2f, 0xd3,
+	0xe5, 0x27, 0x51, 0x00, 0x74, 0x02, 0x65, 0xcb, 0x09, 0x04, 0x73, 0x4c, 0xde, 0xda, 0x6b, 0x6b,
+	0x9d, 0x1a, 0x89, 0x63, 0x3c, 0x85, 0xa2, 0x92, 0x77, 0x00, 0xe5, 0xab, 0x11, 0xed, 0xf5, 0x8d,
+	0xc1, 0x55, 0x23, 0x17, 0x46, 0x7d, 0x63, 0x72, 0xf9, 0xd3, 0x68, 0x7c, 0xdd, 0xd0, 0x50, 0x0d,
+	0x2a, 0xc6, 0x88, 0xde, 0x0e, 0xc6, 0x61, 0x98, 0x0f, 0x37, 0x8d, 0x41, 0x8f, 0xc8, 0x68, 0x0f,
+	0xd5, 0x01, 0x86, 0x13, 0x72, 0xdf, 0x23, 0x57, 0x61, 0x5c, 0xc0, 0x01, 0xd4, 0xd6, 0x32, 0xfa,
+	0x4c, 0x98, 0x4f, 0xe8, 0x3b, 0x28, 0x49, 0x1d, 0x41, 0x4b, 0x6b, 0xef, 0x75, 0xaa, 0xdd, 0x4f,
+	0xb7, 0x29, 0x27, 0xd1, 0x59, 0xf4, 0x35, 0x34, 0x7d, 0xd7, 0xb6, 0x1f, 0x99, 0xf9, 0x3c, 0x71,
+	0x86, 0xcc, 0xb2, 0x57, 0xbe, 0x4a, 0xbd, 0x4c, 0x36, 0x37, 0xf0, 0x37, 0xf2, 0xd2, 0xde, 0x82,
+	0x5b, 0xce, 0xe2, 0xd6, 0x5a, 0x72, 0xf4, 0x39, 0x00, 0x8b, 0x23, 0x59, 0xee, 0x1a, 0x49, 0xad,
+	0xe0, 0x9f, 0x25, 0x60, 0xc8, 0x02, 0xa1, 0x40, 0xbb, 0x00, 0xa8, 0x0d, 0xd5, 0xd9, 0xca, 0x67,
+	0xc2, 0x72, 0x1d, 0xca, 0x4d, 0xa9, 0xa4, 0x46, 0xd2, 0x4b, 0xf8, 0x2f, 0x0d, 0xea, 0xb1, 0x08,
+	0x55, 0xd4, 0x5d, 0xa4, 0x67, 0xd0, 0x60, 0xa6, 0xb0, 0xfe, 0xe0, 0x89, 0xf2, 0x88, 0x79, 0x63,
+	0x3d, 0xe4, 0x9a, 0xc7, 0x72, 0xe5, 0x7b, 0x2c, 0x93, 0xd4, 0x0a, 0xfa, 0x1e, 0x8e, 0x93, 0x88,
+	0xf0, 0x25, 0xb3, 0x9c, 0x50, 0x07, 0x37, 0x5b, 0x05, 0xc9, 0x98, 0xb1, 0x8b, 0x41, 0x7a, 0x74,
+	0xb0, 0xf4, 0xc4, 0x0b, 0xfe, 0x4f, 0x83, 0x26, 0x15, 0x9e, 0xc1, 0x99, 0x1f, 0x6e, 0x4f, 0x5d,
+	0xdb, 0x32, 0x5f, 0x3e, 0xd8, 0xb9, 0x17, 0x50, 0x58, 0xba, 0xb3, 0xb5, 0x71, 0xcf, 0x32, 0xa0,
+	0xaf, 0x2f, 0xd5, 0x6f, 0xdc, 0x19, 0x27, 0x12, 0x87, 0x30, 0x1c, 0xcc, 0xed, 0x55, 0xf0, 0x34,
+	0x71, 0xfa, 0xb6, 0x6b, 0x3e, 0x47, 0xb9, 0xbf, 0x5a, 0xc3, 0x3f, 0x42, 0x21, 0x44, 0x28, 0x37,
+	0x1a, 0xc6, 0xe4, 0xfe, 0x81, 0xde, 0x4e, 0x1b, 0x39, 0x84, 0xa0, 0xde, 0x33, 0xee, 0x7b, 0xbf,
+	0xd0, 0x87, 0xc1, 0x58, 0x99, 0x5b, 0x43, 0x9f, 0xc0, 0x61, 0xb4, 0x16, 0x3b, 0x3e, 0x8f, 0x6d,
+	0xa8, 0x50, 0xe1, 0x11, 0x1e, 0xac, 0x6c, 0x81, 0xce, 0xa1, 0xe4, 0xcb, 0x27, 0x99, 0x6e, 0xbd,
+	0xfb, 0x45, 0x86, 0x66, 0x75, 0x5c, 0x57, 0x5f, 0x24, 0xc2, 0xe0, 0x53, 0x28, 0x45, 0x3c, 0x00,
+	0xa5, 0x61, 0x6f, 0xa4, 0x5a, 0xaa, 0x0a, 0xfb, 0xf4, 0xee, 0xf2, 0x72, 0x40, 0x69, 0x43, 0xc3,
+	0x7f, 0x2a, 0xaf, 0x28, 0xcb, 0xab, 0xb3, 0xdd, 0x75, 0x7f, 0xab, 0x0a, 0x6f, 0xef, 0x92, 0xa8,
+	0xb3, 0x13, 0x9d, 0xf9, 0xf7, 0xd7, 0x89, 0x8e, 0xa0, 0xc8, 0x7d, 0xdf, 0xf5, 0x65, 0x41, 0x2b,
+	0x44, 0x05, 0xf8, 0x6f, 0x25, 0x4d, 0xf6, 0xee, 0xc7, 0x28, 0x07, 0x3a, 0x87, 0x7d, 0xf5, 0x14,
+	0xb4, 0xf2, 0x72, 0x00, 0x6c, 0x1d, 0x5d, 0x11, 0x78, 0x0d, 0x09, 0x6d, 0x1f, 0xb6, 0x3b, 0x9f,
+	0xf5, 0x59, 0xfc, 0xea, 0x53, 0x2b, 0x49, 0x12, 0x85, 0x54, 0x12, 0xdd, 0x7f, 0x8a, 0xb2, 0xbf,
+	0x6f, 0xe2, 0x69, 0x8e, 0x08, 0x34, 0x29, 0x17, 0xb1, 0x3f, 0x55, 0x7f, 0x6e, 0x2d, 0xf2, 0xc9,
+	0x67, 0x5b, 0xd3, 0xc4, 0x39, 0x74, 0x03, 0xe5, 0x61, 0x68, 0xc2, 0xe1, 0xec, 0x11, 0xed, 0xec,
+	0x88, 0xdd, 0x74, 0x14, 0x6a, 0x94, 0x8b, 0x54, 0xcb, 0x9f, 0x66, 0x20, 0x92, 0x23, 0x6f, 0x22,
+	0xbd, 0x7e, 0x45, 0x9a, 0x95, 0xb3, 0x9c, 0x00, 0x27, 0x78, 0xeb, 0x95, 0xb2, 0x2e, 0x38, 0x87,
+	0xee, 0xe0, 0x90, 0x0a, 0xe6, 0x8b, 0xd4, 0xfc, 0xcc, 0xd2, 0x9a, 0x1c, 0xd9, 0xad, 0xf5, 0x37,
+	0x40, 0x1b, 0xef, 0x28, 0xc8, 0x64, 0x4e, 0x7e, 0x62, 0x32, 0x55, 0xa7, 0x4c, 0x8c, 0x73, 0xe8,
+	0x57, 0x69, 0x81, 0x77, 0x86, 0xdb, 0x97, 0x6f, 0x1a, 0x47, 0xbb, 0xb5, 0xff, 0x0e, 0xcd, 0xeb,
+	0x0d, 0xf2, 0xdd, 0xa6, 0x78, 0xdb, 0xf5, 0x38, 0xf7, 0x58, 0x92, 0x7f, 0x47, 0xbe, 0xfd, 0x7f,
+	0x00, 0xe1, 0x6d, 0x8a, 0x35, 0xa6, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -301,6 +734,11 @@ type StpManagementClient interface {
 	SetInterfaceState(ctx context.Context, in *StpState, opts ...grpc.CallOption) (*StpResult, error)
 	FlushFdb(ctx context.Context, in *StpInterface, opts ...grpc.CallOption) (*StpResult, error)
 	SetAgeingTime(ctx context.Context, in *StpAgeingTime, opts ...grpc.CallOption) (*StpResult, error)
+	GetAgeingTime(ctx context.Context, in *StpEmpty, opts ...grpc.CallOption) (*StpAgeingState, error)
+	StartFastAgeing(ctx context.Context, in *StpFastAgeing, opts ...grpc.CallOption) (*StpResult, error)
+	SetInterfaceStates(ctx context.Context, in *StpStateBatch, opts ...grpc.CallOption) (*StpBatchResult, error)
+	SetLearningPolicy(ctx context.Context, in *StpLearningPolicy, opts ...grpc.CallOption) (*StpResult, error)
+	GetLearningPolicy(ctx context.Context, in *StpInterface, opts ...grpc.CallOption) (*StpLearningPolicy, error)
 }
 
 type stpManagementClient struct {
@@ -338,11 +776,61 @@ func (c *stpManagementClient) SetAgeingTime(ctx context.Context, in *StpAgeingTi
 	return out, nil
 }
 
+func (c *stpManagementClient) GetAgeingTime(ctx context.Context, in *StpEmpty, opts ...grpc.CallOption) (*StpAgeingState, error) {
+	out := new(StpAgeingState)
+	err := c.cc.Invoke(ctx, "/OpenNos.Plugin.Stp.StpManagement/GetAgeingTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stpManagementClient) StartFastAgeing(ctx context.Context, in *StpFastAgeing, opts ...grpc.CallOption) (*StpResult, error) {
+	out := new(StpResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Plugin.Stp.StpManagement/StartFastAgeing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stpManagementClient) SetInterfaceStates(ctx context.Context, in *StpStateBatch, opts ...grpc.CallOption) (*StpBatchResult, error) {
+	out := new(StpBatchResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Plugin.Stp.StpManagement/SetInterfaceStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stpManagementClient) SetLearningPolicy(ctx context.Context, in *StpLearningPolicy, opts ...grpc.CallOption) (*StpResult, error) {
+	out := new(StpResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Plugin.Stp.StpManagement/SetLearningPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stpManagementClient) GetLearningPolicy(ctx context.Context, in *StpInterface, opts ...grpc.CallOption) (*StpLearningPolicy, error) {
+	out := new(StpLearningPolicy)
+	err := c.cc.Invoke(ctx, "/OpenNos.Plugin.Stp.StpManagement/GetLearningPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StpManagementServer is the server API for StpManagement service.
 type StpManagementServer interface {
 	SetInterfaceState(context.Context, *StpState) (*StpResult, error)
 	FlushFdb(context.Context, *StpInterface) (*StpResult, error)
 	SetAgeingTime(context.Context, *StpAgeingTime) (*StpResult, error)
+	GetAgeingTime(context.Context, *StpEmpty) (*StpAgeingState, error)
+	StartFastAgeing(context.Context, *StpFastAgeing) (*StpResult, error)
+	SetInterfaceStates(context.Context, *StpStateBatch) (*StpBatchResult, error)
+	SetLearningPolicy(context.Context, *StpLearningPolicy) (*StpResult, error)
+	GetLearningPolicy(context.Context, *StpInterface) (*StpLearningPolicy, error)
 }
 
 // UnimplementedStpManagementServer can be embedded to have forward compatible implementations.
@@ -358,6 +846,21 @@ func (*UnimplementedStpManagementServer) FlushFdb(ctx context.Context, req *StpI
 func (*UnimplementedStpManagementServer) SetAgeingTime(ctx context.Context, req *StpAgeingTime) (*StpResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAgeingTime not implemented")
 }
+func (*UnimplementedStpManagementServer) GetAgeingTime(ctx context.Context, req *StpEmpty) (*StpAgeingState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgeingTime not implemented")
+}
+func (*UnimplementedStpManagementServer) StartFastAgeing(ctx context.Context, req *StpFastAgeing) (*StpResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFastAgeing not implemented")
+}
+func (*UnimplementedStpManagementServer) SetInterfaceStates(ctx context.Context, req *StpStateBatch) (*StpBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceStates not implemented")
+}
+func (*UnimplementedStpManagementServer) SetLearningPolicy(ctx context.Context, req *StpLearningPolicy) (*StpResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLearningPolicy not implemented")
+}
+func (*UnimplementedStpManagementServer) GetLearningPolicy(ctx context.Context, req *StpInterface) (*StpLearningPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningPolicy not implemented")
+}
 
 func RegisterStpManagementServer(s *grpc.Server, srv StpManagementServer) {
 	s.RegisterService(&_StpManagement_serviceDesc, srv)
@@ -417,6 +920,96 @@ func _StpManagement_SetAgeingTime_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StpManagement_GetAgeingTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StpEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StpManagementServer).GetAgeingTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Plugin.Stp.StpManagement/GetAgeingTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StpManagementServer).GetAgeingTime(ctx, req.(*StpEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StpManagement_StartFastAgeing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StpFastAgeing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StpManagementServer).StartFastAgeing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Plugin.Stp.StpManagement/StartFastAgeing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StpManagementServer).StartFastAgeing(ctx, req.(*StpFastAgeing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StpManagement_SetInterfaceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StpStateBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StpManagementServer).SetInterfaceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Plugin.Stp.StpManagement/SetInterfaceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StpManagementServer).SetInterfaceStates(ctx, req.(*StpStateBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StpManagement_SetLearningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StpLearningPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StpManagementServer).SetLearningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Plugin.Stp.StpManagement/SetLearningPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StpManagementServer).SetLearningPolicy(ctx, req.(*StpLearningPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StpManagement_GetLearningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StpInterface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StpManagementServer).GetLearningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Plugin.Stp.StpManagement/GetLearningPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StpManagementServer).GetLearningPolicy(ctx, req.(*StpInterface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StpManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenNos.Plugin.Stp.StpManagement",
 	HandlerType: (*StpManagementServer)(nil),
@@ -433,6 +1026,26 @@ var _StpManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAgeingTime",
 			Handler:    _StpManagement_SetAgeingTime_Handler,
 		},
+		{
+			MethodName: "GetAgeingTime",
+			Handler:    _StpManagement_GetAgeingTime_Handler,
+		},
+		{
+			MethodName: "StartFastAgeing",
+			Handler:    _StpManagement_StartFastAgeing_Handler,
+		},
+		{
+			MethodName: "SetInterfaceStates",
+			Handler:    _StpManagement_SetInterfaceStates_Handler,
+		},
+		{
+			MethodName: "SetLearningPolicy",
+			Handler:    _StpManagement_SetLearningPolicy_Handler,
+		},
+		{
+			MethodName: "GetLearningPolicy",
+			Handler:    _StpManagement_GetLearningPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stp_management.proto",
@@ -0,0 +1,1675 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: vlan_management.proto
+
+package OpenNos_Switch_Vlan
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type VlanQinqPort_Mode int32
+
+const (
+	VlanQinqPort_NONE VlanQinqPort_Mode = 0
+	// Frames of customer get outer tag of service VLAN, which is PVID of the port
+	VlanQinqPort_CUSTOMER VlanQinqPort_Mode = 1
+	// Port of provider network, which carries frames with outer tag
+	VlanQinqPort_PROVIDER VlanQinqPort_Mode = 2
+)
+
+var VlanQinqPort_Mode_name = map[int32]string{
+	0: "NONE",
+	1: "CUSTOMER",
+	2: "PROVIDER",
+}
+
+var VlanQinqPort_Mode_value = map[string]int32{
+	"NONE":     0,
+	"CUSTOMER": 1,
+	"PROVIDER": 2,
+}
+
+func (x VlanQinqPort_Mode) String() string {
+	return proto.EnumName(VlanQinqPort_Mode_name, int32(x))
+}
+
+func (VlanQinqPort_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{4, 0}
+}
+
+type VlanTranslation_Direction int32
+
+const (
+	VlanTranslation_INGRESS VlanTranslation_Direction = 0
+	VlanTranslation_EGRESS  VlanTranslation_Direction = 1
+)
+
+var VlanTranslation_Direction_name = map[int32]string{
+	0: "INGRESS",
+	1: "EGRESS",
+}
+
+var VlanTranslation_Direction_value = map[string]int32{
+	"INGRESS": 0,
+	"EGRESS":  1,
+}
+
+func (x VlanTranslation_Direction) String() string {
+	return proto.EnumName(VlanTranslation_Direction_name, int32(x))
+}
+
+func (VlanTranslation_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{5, 0}
+}
+
+type VlanSubIface_Mode int32
+
+const (
+	// Frames are delivered untagged to KNET netdev named <ifname>.<vid>
+	VlanSubIface_KNET_NETDEV VlanSubIface_Mode = 0
+	// Frames are delivered tagged to port netdev, so Linux VLAN device can be used
+	VlanSubIface_TAGGED_TO_PORT VlanSubIface_Mode = 1
+)
+
+var VlanSubIface_Mode_name = map[int32]string{
+	0: "KNET_NETDEV",
+	1: "TAGGED_TO_PORT",
+}
+
+var VlanSubIface_Mode_value = map[string]int32{
+	"KNET_NETDEV":    0,
+	"TAGGED_TO_PORT": 1,
+}
+
+func (x VlanSubIface_Mode) String() string {
+	return proto.EnumName(VlanSubIface_Mode_name, int32(x))
+}
+
+func (VlanSubIface_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{8, 0}
+}
+
+type VlanIsolationPort_Role int32
+
+const (
+	// Reaches promiscuous ports only
+	VlanIsolationPort_ISOLATED VlanIsolationPort_Role = 0
+	// Reaches promiscuous ports and ports of the same community
+	VlanIsolationPort_COMMUNITY VlanIsolationPort_Role = 1
+	// Uplink, which reaches all ports of the group
+	VlanIsolationPort_PROMISCUOUS VlanIsolationPort_Role = 2
+)
+
+var VlanIsolationPort_Role_name = map[int32]string{
+	0: "ISOLATED",
+	1: "COMMUNITY",
+	2: "PROMISCUOUS",
+}
+
+var VlanIsolationPort_Role_value = map[string]int32{
+	"ISOLATED":    0,
+	"COMMUNITY":   1,
+	"PROMISCUOUS": 2,
+}
+
+func (x VlanIsolationPort_Role) String() string {
+	return proto.EnumName(VlanIsolationPort_Role_name, int32(x))
+}
+
+func (VlanIsolationPort_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{10, 0}
+}
+
+type VlanResult_Result int32
+
+const (
+	VlanResult_FAILED  VlanResult_Result = 0
+	VlanResult_SUCCESS VlanResult_Result = 1
+)
+
+var VlanResult_Result_name = map[int32]string{
+	0: "FAILED",
+	1: "SUCCESS",
+}
+
+var VlanResult_Result_value = map[string]int32{
+	"FAILED":  0,
+	"SUCCESS": 1,
+}
+
+func (x VlanResult_Result) String() string {
+	return proto.EnumName(VlanResult_Result_name, int32(x))
+}
+
+func (VlanResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{15, 0}
+}
+
+type VlanId struct {
+	Vid                  uint32   `protobuf:"varint,1,opt,name=vid,proto3" json:"vid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanId) Reset()         { *m = VlanId{} }
+func (m *VlanId) String() string { return proto.CompactTextString(m) }
+func (*VlanId) ProtoMessage()    {}
+func (*VlanId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{0}
+}
+
+func (m *VlanId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanId.Unmarshal(m, b)
+}
+func (m *VlanId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanId.Marshal(b, m, deterministic)
+}
+func (m *VlanId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanId.Merge(m, src)
+}
+func (m *VlanId) XXX_Size() int {
+	return xxx_messageInfo_VlanId.Size(m)
+}
+func (m *VlanId) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanId proto.InternalMessageInfo
+
+func (m *VlanId) GetVid() uint32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+type VlanMember struct {
+	Vid uint32 `protobuf:"varint,1,opt,name=vid,proto3" json:"vid,omitempty"`
+	// Name of physical port or LAG
+	Ifname               string   `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Untagged             bool     `protobuf:"varint,3,opt,name=untagged,proto3" json:"untagged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanMember) Reset()         { *m = VlanMember{} }
+func (m *VlanMember) String() string { return proto.CompactTextString(m) }
+func (*VlanMember) ProtoMessage()    {}
+func (*VlanMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{1}
+}
+
+func (m *VlanMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanMember.Unmarshal(m, b)
+}
+func (m *VlanMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanMember.Marshal(b, m, deterministic)
+}
+func (m *VlanMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanMember.Merge(m, src)
+}
+func (m *VlanMember) XXX_Size() int {
+	return xxx_messageInfo_VlanMember.Size(m)
+}
+func (m *VlanMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanMember proto.InternalMessageInfo
+
+func (m *VlanMember) GetVid() uint32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+func (m *VlanMember) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanMember) GetUntagged() bool {
+	if m != nil {
+		return m.Untagged
+	}
+	return false
+}
+
+type VlanPvid struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Vid                  uint32   `protobuf:"varint,2,opt,name=vid,proto3" json:"vid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanPvid) Reset()         { *m = VlanPvid{} }
+func (m *VlanPvid) String() string { return proto.CompactTextString(m) }
+func (*VlanPvid) ProtoMessage()    {}
+func (*VlanPvid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{2}
+}
+
+func (m *VlanPvid) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanPvid.Unmarshal(m, b)
+}
+func (m *VlanPvid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanPvid.Marshal(b, m, deterministic)
+}
+func (m *VlanPvid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanPvid.Merge(m, src)
+}
+func (m *VlanPvid) XXX_Size() int {
+	return xxx_messageInfo_VlanPvid.Size(m)
+}
+func (m *VlanPvid) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanPvid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanPvid proto.InternalMessageInfo
+
+func (m *VlanPvid) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanPvid) GetVid() uint32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+type VlanInfo struct {
+	Vid                  uint32        `protobuf:"varint,1,opt,name=vid,proto3" json:"vid,omitempty"`
+	Members              []*VlanMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VlanInfo) Reset()         { *m = VlanInfo{} }
+func (m *VlanInfo) String() string { return proto.CompactTextString(m) }
+func (*VlanInfo) ProtoMessage()    {}
+func (*VlanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{3}
+}
+
+func (m *VlanInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanInfo.Unmarshal(m, b)
+}
+func (m *VlanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanInfo.Marshal(b, m, deterministic)
+}
+func (m *VlanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanInfo.Merge(m, src)
+}
+func (m *VlanInfo) XXX_Size() int {
+	return xxx_messageInfo_VlanInfo.Size(m)
+}
+func (m *VlanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanInfo proto.InternalMessageInfo
+
+func (m *VlanInfo) GetVid() uint32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+func (m *VlanInfo) GetMembers() []*VlanMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type VlanQinqPort struct {
+	Ifname string            `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Mode   VlanQinqPort_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=OpenNos.Switch.Vlan.VlanQinqPort_Mode" json:"mode,omitempty"`
+	// TPID of outer tag on provider port, 0x88a8 if not set
+	Tpid                 uint32   `protobuf:"varint,3,opt,name=tpid,proto3" json:"tpid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanQinqPort) Reset()         { *m = VlanQinqPort{} }
+func (m *VlanQinqPort) String() string { return proto.CompactTextString(m) }
+func (*VlanQinqPort) ProtoMessage()    {}
+func (*VlanQinqPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{4}
+}
+
+func (m *VlanQinqPort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanQinqPort.Unmarshal(m, b)
+}
+func (m *VlanQinqPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanQinqPort.Marshal(b, m, deterministic)
+}
+func (m *VlanQinqPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanQinqPort.Merge(m, src)
+}
+func (m *VlanQinqPort) XXX_Size() int {
+	return xxx_messageInfo_VlanQinqPort.Size(m)
+}
+func (m *VlanQinqPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanQinqPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanQinqPort proto.InternalMessageInfo
+
+func (m *VlanQinqPort) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanQinqPort) GetMode() VlanQinqPort_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return VlanQinqPort_NONE
+}
+
+func (m *VlanQinqPort) GetTpid() uint32 {
+	if m != nil {
+		return m.Tpid
+	}
+	return 0
+}
+
+type VlanTranslation struct {
+	Ifname    string                    `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Direction VlanTranslation_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=OpenNos.Switch.Vlan.VlanTranslation_Direction" json:"direction,omitempty"`
+	OuterVid  uint32                    `protobuf:"varint,3,opt,name=outerVid,proto3" json:"outerVid,omitempty"`
+	// Zero matches frames regardless of inner tag
+	InnerVid    uint32 `protobuf:"varint,4,opt,name=innerVid,proto3" json:"innerVid,omitempty"`
+	NewOuterVid uint32 `protobuf:"varint,5,opt,name=newOuterVid,proto3" json:"newOuterVid,omitempty"`
+	// Zero leaves inner tag unchanged
+	NewInnerVid          uint32   `protobuf:"varint,6,opt,name=newInnerVid,proto3" json:"newInnerVid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanTranslation) Reset()         { *m = VlanTranslation{} }
+func (m *VlanTranslation) String() string { return proto.CompactTextString(m) }
+func (*VlanTranslation) ProtoMessage()    {}
+func (*VlanTranslation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{5}
+}
+
+func (m *VlanTranslation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanTranslation.Unmarshal(m, b)
+}
+func (m *VlanTranslation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanTranslation.Marshal(b, m, deterministic)
+}
+func (m *VlanTranslation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanTranslation.Merge(m, src)
+}
+func (m *VlanTranslation) XXX_Size() int {
+	return xxx_messageInfo_VlanTranslation.Size(m)
+}
+func (m *VlanTranslation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanTranslation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanTranslation proto.InternalMessageInfo
+
+func (m *VlanTranslation) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanTranslation) GetDirection() VlanTranslation_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return VlanTranslation_INGRESS
+}
+
+func (m *VlanTranslation) GetOuterVid() uint32 {
+	if m != nil {
+		return m.OuterVid
+	}
+	return 0
+}
+
+func (m *VlanTranslation) GetInnerVid() uint32 {
+	if m != nil {
+		return m.InnerVid
+	}
+	return 0
+}
+
+func (m *VlanTranslation) GetNewOuterVid() uint32 {
+	if m != nil {
+		return m.NewOuterVid
+	}
+	return 0
+}
+
+func (m *VlanTranslation) GetNewInnerVid() uint32 {
+	if m != nil {
+		return m.NewInnerVid
+	}
+	return 0
+}
+
+type VlanList struct {
+	Vlans                []*VlanInfo        `protobuf:"bytes,1,rep,name=vlans,proto3" json:"vlans,omitempty"`
+	Pvids                []*VlanPvid        `protobuf:"bytes,2,rep,name=pvids,proto3" json:"pvids,omitempty"`
+	QinqPorts            []*VlanQinqPort    `protobuf:"bytes,3,rep,name=qinqPorts,proto3" json:"qinqPorts,omitempty"`
+	Translations         []*VlanTranslation `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VlanList) Reset()         { *m = VlanList{} }
+func (m *VlanList) String() string { return proto.CompactTextString(m) }
+func (*VlanList) ProtoMessage()    {}
+func (*VlanList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{6}
+}
+
+func (m *VlanList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanList.Unmarshal(m, b)
+}
+func (m *VlanList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanList.Marshal(b, m, deterministic)
+}
+func (m *VlanList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanList.Merge(m, src)
+}
+func (m *VlanList) XXX_Size() int {
+	return xxx_messageInfo_VlanList.Size(m)
+}
+func (m *VlanList) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanList proto.InternalMessageInfo
+
+func (m *VlanList) GetVlans() []*VlanInfo {
+	if m != nil {
+		return m.Vlans
+	}
+	return nil
+}
+
+func (m *VlanList) GetPvids() []*VlanPvid {
+	if m != nil {
+		return m.Pvids
+	}
+	return nil
+}
+
+func (m *VlanList) GetQinqPorts() []*VlanQinqPort {
+	if m != nil {
+		return m.QinqPorts
+	}
+	return nil
+}
+
+func (m *VlanList) GetTranslations() []*VlanTranslation {
+	if m != nil {
+		return m.Translations
+	}
+	return nil
+}
+
+type VlanTagStripping struct {
+	Ifname               string   `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Strip                bool     `protobuf:"varint,2,opt,name=strip,proto3" json:"strip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanTagStripping) Reset()         { *m = VlanTagStripping{} }
+func (m *VlanTagStripping) String() string { return proto.CompactTextString(m) }
+func (*VlanTagStripping) ProtoMessage()    {}
+func (*VlanTagStripping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{7}
+}
+
+func (m *VlanTagStripping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanTagStripping.Unmarshal(m, b)
+}
+func (m *VlanTagStripping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanTagStripping.Marshal(b, m, deterministic)
+}
+func (m *VlanTagStripping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanTagStripping.Merge(m, src)
+}
+func (m *VlanTagStripping) XXX_Size() int {
+	return xxx_messageInfo_VlanTagStripping.Size(m)
+}
+func (m *VlanTagStripping) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanTagStripping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanTagStripping proto.InternalMessageInfo
+
+func (m *VlanTagStripping) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanTagStripping) GetStrip() bool {
+	if m != nil {
+		return m.Strip
+	}
+	return false
+}
+
+type VlanSubIface struct {
+	// Name of physical port
+	Ifname               string            `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Vid                  uint32            `protobuf:"varint,2,opt,name=vid,proto3" json:"vid,omitempty"`
+	Mode                 VlanSubIface_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=OpenNos.Switch.Vlan.VlanSubIface_Mode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *VlanSubIface) Reset()         { *m = VlanSubIface{} }
+func (m *VlanSubIface) String() string { return proto.CompactTextString(m) }
+func (*VlanSubIface) ProtoMessage()    {}
+func (*VlanSubIface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{8}
+}
+
+func (m *VlanSubIface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanSubIface.Unmarshal(m, b)
+}
+func (m *VlanSubIface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanSubIface.Marshal(b, m, deterministic)
+}
+func (m *VlanSubIface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanSubIface.Merge(m, src)
+}
+func (m *VlanSubIface) XXX_Size() int {
+	return xxx_messageInfo_VlanSubIface.Size(m)
+}
+func (m *VlanSubIface) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanSubIface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanSubIface proto.InternalMessageInfo
+
+func (m *VlanSubIface) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanSubIface) GetVid() uint32 {
+	if m != nil {
+		return m.Vid
+	}
+	return 0
+}
+
+func (m *VlanSubIface) GetMode() VlanSubIface_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return VlanSubIface_KNET_NETDEV
+}
+
+type VlanSubIfaceList struct {
+	SubIfaces            []*VlanSubIface     `protobuf:"bytes,1,rep,name=subIfaces,proto3" json:"subIfaces,omitempty"`
+	TagStripping         []*VlanTagStripping `protobuf:"bytes,2,rep,name=tagStripping,proto3" json:"tagStripping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *VlanSubIfaceList) Reset()         { *m = VlanSubIfaceList{} }
+func (m *VlanSubIfaceList) String() string { return proto.CompactTextString(m) }
+func (*VlanSubIfaceList) ProtoMessage()    {}
+func (*VlanSubIfaceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{9}
+}
+
+func (m *VlanSubIfaceList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanSubIfaceList.Unmarshal(m, b)
+}
+func (m *VlanSubIfaceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanSubIfaceList.Marshal(b, m, deterministic)
+}
+func (m *VlanSubIfaceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanSubIfaceList.Merge(m, src)
+}
+func (m *VlanSubIfaceList) XXX_Size() int {
+	return xxx_messageInfo_VlanSubIfaceList.Size(m)
+}
+func (m *VlanSubIfaceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanSubIfaceList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanSubIfaceList proto.InternalMessageInfo
+
+func (m *VlanSubIfaceList) GetSubIfaces() []*VlanSubIface {
+	if m != nil {
+		return m.SubIfaces
+	}
+	return nil
+}
+
+func (m *VlanSubIfaceList) GetTagStripping() []*VlanTagStripping {
+	if m != nil {
+		return m.TagStripping
+	}
+	return nil
+}
+
+type VlanIsolationPort struct {
+	// Name of physical port
+	Ifname               string                 `protobuf:"bytes,1,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Role                 VlanIsolationPort_Role `protobuf:"varint,2,opt,name=role,proto3,enum=OpenNos.Switch.Vlan.VlanIsolationPort_Role" json:"role,omitempty"`
+	Community            string                 `protobuf:"bytes,3,opt,name=community,proto3" json:"community,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *VlanIsolationPort) Reset()         { *m = VlanIsolationPort{} }
+func (m *VlanIsolationPort) String() string { return proto.CompactTextString(m) }
+func (*VlanIsolationPort) ProtoMessage()    {}
+func (*VlanIsolationPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{10}
+}
+
+func (m *VlanIsolationPort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanIsolationPort.Unmarshal(m, b)
+}
+func (m *VlanIsolationPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanIsolationPort.Marshal(b, m, deterministic)
+}
+func (m *VlanIsolationPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanIsolationPort.Merge(m, src)
+}
+func (m *VlanIsolationPort) XXX_Size() int {
+	return xxx_messageInfo_VlanIsolationPort.Size(m)
+}
+func (m *VlanIsolationPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanIsolationPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanIsolationPort proto.InternalMessageInfo
+
+func (m *VlanIsolationPort) GetIfname() string {
+	if m != nil {
+		return m.Ifname
+	}
+	return ""
+}
+
+func (m *VlanIsolationPort) GetRole() VlanIsolationPort_Role {
+	if m != nil {
+		return m.Role
+	}
+	return VlanIsolationPort_ISOLATED
+}
+
+func (m *VlanIsolationPort) GetCommunity() string {
+	if m != nil {
+		return m.Community
+	}
+	return ""
+}
+
+type VlanIsolationGroup struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ports                []*VlanIsolationPort `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VlanIsolationGroup) Reset()         { *m = VlanIsolationGroup{} }
+func (m *VlanIsolationGroup) String() string { return proto.CompactTextString(m) }
+func (*VlanIsolationGroup) ProtoMessage()    {}
+func (*VlanIsolationGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{11}
+}
+
+func (m *VlanIsolationGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanIsolationGroup.Unmarshal(m, b)
+}
+func (m *VlanIsolationGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanIsolationGroup.Marshal(b, m, deterministic)
+}
+func (m *VlanIsolationGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanIsolationGroup.Merge(m, src)
+}
+func (m *VlanIsolationGroup) XXX_Size() int {
+	return xxx_messageInfo_VlanIsolationGroup.Size(m)
+}
+func (m *VlanIsolationGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanIsolationGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanIsolationGroup proto.InternalMessageInfo
+
+func (m *VlanIsolationGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VlanIsolationGroup) GetPorts() []*VlanIsolationPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type VlanIsolationGroupName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanIsolationGroupName) Reset()         { *m = VlanIsolationGroupName{} }
+func (m *VlanIsolationGroupName) String() string { return proto.CompactTextString(m) }
+func (*VlanIsolationGroupName) ProtoMessage()    {}
+func (*VlanIsolationGroupName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{12}
+}
+
+func (m *VlanIsolationGroupName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanIsolationGroupName.Unmarshal(m, b)
+}
+func (m *VlanIsolationGroupName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanIsolationGroupName.Marshal(b, m, deterministic)
+}
+func (m *VlanIsolationGroupName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanIsolationGroupName.Merge(m, src)
+}
+func (m *VlanIsolationGroupName) XXX_Size() int {
+	return xxx_messageInfo_VlanIsolationGroupName.Size(m)
+}
+func (m *VlanIsolationGroupName) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanIsolationGroupName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanIsolationGroupName proto.InternalMessageInfo
+
+func (m *VlanIsolationGroupName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VlanIsolationGroupList struct {
+	Groups               []*VlanIsolationGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VlanIsolationGroupList) Reset()         { *m = VlanIsolationGroupList{} }
+func (m *VlanIsolationGroupList) String() string { return proto.CompactTextString(m) }
+func (*VlanIsolationGroupList) ProtoMessage()    {}
+func (*VlanIsolationGroupList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{13}
+}
+
+func (m *VlanIsolationGroupList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanIsolationGroupList.Unmarshal(m, b)
+}
+func (m *VlanIsolationGroupList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanIsolationGroupList.Marshal(b, m, deterministic)
+}
+func (m *VlanIsolationGroupList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanIsolationGroupList.Merge(m, src)
+}
+func (m *VlanIsolationGroupList) XXX_Size() int {
+	return xxx_messageInfo_VlanIsolationGroupList.Size(m)
+}
+func (m *VlanIsolationGroupList) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanIsolationGroupList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanIsolationGroupList proto.InternalMessageInfo
+
+func (m *VlanIsolationGroupList) GetGroups() []*VlanIsolationGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+type VlanEmpty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanEmpty) Reset()         { *m = VlanEmpty{} }
+func (m *VlanEmpty) String() string { return proto.CompactTextString(m) }
+func (*VlanEmpty) ProtoMessage()    {}
+func (*VlanEmpty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{14}
+}
+
+func (m *VlanEmpty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanEmpty.Unmarshal(m, b)
+}
+func (m *VlanEmpty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanEmpty.Marshal(b, m, deterministic)
+}
+func (m *VlanEmpty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanEmpty.Merge(m, src)
+}
+func (m *VlanEmpty) XXX_Size() int {
+	return xxx_messageInfo_VlanEmpty.Size(m)
+}
+func (m *VlanEmpty) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanEmpty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanEmpty proto.InternalMessageInfo
+
+type VlanResult struct {
+	Result               VlanResult_Result `protobuf:"varint,1,opt,name=result,proto3,enum=OpenNos.Switch.Vlan.VlanResult_Result" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *VlanResult) Reset()         { *m = VlanResult{} }
+func (m *VlanResult) String() string { return proto.CompactTextString(m) }
+func (*VlanResult) ProtoMessage()    {}
+func (*VlanResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21efb2e8adf7b3b, []int{15}
+}
+
+func (m *VlanResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanResult.Unmarshal(m, b)
+}
+func (m *VlanResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanResult.Marshal(b, m, deterministic)
+}
+func (m *VlanResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanResult.Merge(m, src)
+}
+func (m *VlanResult) XXX_Size() int {
+	return xxx_messageInfo_VlanResult.Size(m)
+}
+func (m *VlanResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanResult proto.InternalMessageInfo
+
+func (m *VlanResult) GetResult() VlanResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return VlanResult_FAILED
+}
+
+func init() {
+	proto.RegisterEnum("OpenNos.Switch.Vlan.VlanQinqPort_Mode", VlanQinqPort_Mode_name, VlanQinqPort_Mode_value)
+	proto.RegisterEnum("OpenNos.Switch.Vlan.VlanTranslation_Direction", VlanTranslation_Direction_name, VlanTranslation_Direction_value)
+	proto.RegisterEnum("OpenNos.Switch.Vlan.VlanSubIface_Mode", VlanSubIface_Mode_name, VlanSubIface_Mode_value)
+	proto.RegisterEnum("OpenNos.Switch.Vlan.VlanIsolationPort_Role", VlanIsolationPort_Role_name, VlanIsolationPort_Role_value)
+	proto.RegisterEnum("OpenNos.Switch.Vlan.VlanResult_Result", VlanResult_Result_name, VlanResult_Result_value)
+	proto.RegisterType((*VlanId)(nil), "OpenNos.Switch.Vlan.VlanId")
+	proto.RegisterType((*VlanMember)(nil), "OpenNos.Switch.Vlan.VlanMember")
+	proto.RegisterType((*VlanPvid)(nil), "OpenNos.Switch.Vlan.VlanPvid")
+	proto.RegisterType((*VlanInfo)(nil), "OpenNos.Switch.Vlan.VlanInfo")
+	proto.RegisterType((*VlanQinqPort)(nil), "OpenNos.Switch.Vlan.VlanQinqPort")
+	proto.RegisterType((*VlanTranslation)(nil), "OpenNos.Switch.Vlan.VlanTranslation")
+	proto.RegisterType((*VlanList)(nil), "OpenNos.Switch.Vlan.VlanList")
+	proto.RegisterType((*VlanTagStripping)(nil), "OpenNos.Switch.Vlan.VlanTagStripping")
+	proto.RegisterType((*VlanSubIface)(nil), "OpenNos.Switch.Vlan.VlanSubIface")
+	proto.RegisterType((*VlanSubIfaceList)(nil), "OpenNos.Switch.Vlan.VlanSubIfaceList")
+	proto.RegisterType((*VlanIsolationPort)(nil), "OpenNos.Switch.Vlan.VlanIsolationPort")
+	proto.RegisterType((*VlanIsolationGroup)(nil), "OpenNos.Switch.Vlan.VlanIsolationGroup")
+	proto.RegisterType((*VlanIsolationGroupName)(nil), "OpenNos.Switch.Vlan.VlanIsolationGroupName")
+	proto.RegisterType((*VlanIsolationGroupList)(nil), "OpenNos.Switch.Vlan.VlanIsolationGroupList")
+	proto.RegisterType((*VlanEmpty)(nil), "OpenNos.Switch.Vlan.VlanEmpty")
+	proto.RegisterType((*VlanResult)(nil), "OpenNos.Switch.Vlan.VlanResult")
+}
+
+func init() { proto.RegisterFile("vlan_management.proto", fileDescriptor_b21efb2e8adf7b3b) }
+
+var fileDescriptor_b21efb2e8adf7b3b = []byte{
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x65, 0x59, 0x16, 0x47, 0xbe, 0x30, 0x9b, 0x0b, 0x04, 0xf7, 0x12, 0x79, 0x91, 0xa4,
+	0x06, 0x1c, 0xf0, 0xc1, 0xc9, 0x4b, 0x8b, 0xa2, 0xae, 0x20, 0xb1, 0x2e, 0x11, 0x49, 0x54, 0x97,
+	0x92, 0xda, 0xa0, 0x28, 0x54, 0xda, 0x5c, 0xa9, 0x04, 0x44, 0x52, 0x21, 0x57, 0x0e, 0xf2, 0x29,
+	0x7d, 0x68, 0x81, 0x02, 0xfd, 0x90, 0xfe, 0x59, 0x8b, 0x5d, 0x5e, 0x44, 0x25, 0x66, 0xc8, 0x07,
+	0xf7, 0x49, 0x7b, 0x99, 0x73, 0x66, 0x76, 0xe6, 0xec, 0x2c, 0x05, 0x0f, 0x6f, 0x96, 0x96, 0x37,
+	0x73, 0x2d, 0xcf, 0x5a, 0x50, 0x97, 0x7a, 0x4c, 0x5d, 0x05, 0x3e, 0xf3, 0xd1, 0x7d, 0x63, 0x45,
+	0xbd, 0xa1, 0x1f, 0xaa, 0xe6, 0x5b, 0x87, 0x5d, 0xff, 0xa6, 0x4e, 0x97, 0x96, 0x87, 0x8f, 0xa1,
+	0xce, 0x7f, 0x75, 0x1b, 0x29, 0xb0, 0x73, 0xe3, 0xd8, 0x2d, 0xa9, 0x2d, 0x9d, 0x1e, 0x10, 0x3e,
+	0xc4, 0x04, 0x80, 0xef, 0x0d, 0xa8, 0x7b, 0x45, 0x83, 0x0f, 0xf7, 0xd1, 0x23, 0xa8, 0x3b, 0x73,
+	0xcf, 0x72, 0x69, 0xab, 0xda, 0x96, 0x4e, 0x65, 0x12, 0xcf, 0xd0, 0x31, 0x34, 0xd6, 0x1e, 0xb3,
+	0x16, 0x0b, 0x6a, 0xb7, 0x76, 0xda, 0xd2, 0x69, 0x83, 0xa4, 0x73, 0xfc, 0x12, 0x1a, 0x9c, 0x73,
+	0xb4, 0x8d, 0x97, 0xb6, 0xf0, 0xb1, 0xa7, 0xea, 0x26, 0x92, 0x1f, 0x23, 0x94, 0xee, 0xcd, 0xfd,
+	0x5b, 0xe2, 0xf8, 0x12, 0xf6, 0x5c, 0x11, 0x63, 0xd8, 0xaa, 0xb6, 0x77, 0x4e, 0x9b, 0xe7, 0x8f,
+	0xd5, 0x5b, 0x8e, 0xaa, 0x6e, 0xce, 0x42, 0x12, 0x7b, 0xfc, 0xb7, 0x04, 0xfb, 0x7c, 0xfd, 0x07,
+	0xc7, 0x7b, 0x33, 0xf2, 0x03, 0x96, 0x1b, 0xd3, 0x57, 0x50, 0x73, 0x7d, 0x3b, 0x3a, 0xe9, 0xe1,
+	0xf9, 0xb3, 0x5c, 0x07, 0x09, 0x91, 0x3a, 0xf0, 0x6d, 0x4a, 0x04, 0x06, 0x21, 0xa8, 0xb1, 0x95,
+	0x13, 0xe5, 0xe2, 0x80, 0x88, 0x31, 0x7e, 0x0e, 0x35, 0x6e, 0x81, 0x1a, 0x50, 0x1b, 0x1a, 0x43,
+	0x4d, 0xa9, 0xa0, 0x7d, 0x68, 0x74, 0x27, 0xe6, 0xd8, 0x18, 0x68, 0x44, 0x91, 0xf8, 0x6c, 0x44,
+	0x8c, 0xa9, 0xde, 0xd3, 0x88, 0x52, 0xc5, 0xbf, 0x57, 0xe1, 0x88, 0xb3, 0x8f, 0x03, 0xcb, 0x0b,
+	0x97, 0x16, 0x73, 0x7c, 0x2f, 0x37, 0xd2, 0x3e, 0xc8, 0xb6, 0x13, 0xd0, 0x6b, 0x6e, 0x14, 0x87,
+	0xab, 0xe6, 0x86, 0x9b, 0x21, 0x54, 0x7b, 0x09, 0x8a, 0x6c, 0x08, 0x78, 0x2d, 0xfd, 0x35, 0xa3,
+	0xc1, 0x34, 0x8d, 0x3f, 0x9d, 0xf3, 0x3d, 0xc7, 0xf3, 0xa2, 0xbd, 0x5a, 0xb4, 0x97, 0xcc, 0x51,
+	0x1b, 0x9a, 0x1e, 0x7d, 0x6b, 0x24, 0xd0, 0x5d, 0xb1, 0x9d, 0x5d, 0x8a, 0x2d, 0xf4, 0x84, 0xa0,
+	0x9e, 0x5a, 0x24, 0x4b, 0xf8, 0x09, 0xc8, 0x69, 0x4c, 0xa8, 0x09, 0x7b, 0xfa, 0xf0, 0x92, 0x68,
+	0xa6, 0xa9, 0x54, 0x10, 0x40, 0x5d, 0x8b, 0xc6, 0x12, 0xfe, 0x57, 0x8a, 0xc4, 0xd1, 0x77, 0x42,
+	0x86, 0x5e, 0xc0, 0x2e, 0x17, 0x7f, 0xd8, 0x92, 0x84, 0x10, 0x3e, 0xcb, 0x3d, 0x38, 0x97, 0x12,
+	0x89, 0x6c, 0x39, 0x68, 0x75, 0xe3, 0xd8, 0x89, 0x7a, 0xf2, 0x41, 0x5c, 0xb5, 0x24, 0xb2, 0x45,
+	0x17, 0x20, 0xbf, 0x89, 0x6b, 0x1d, 0xb6, 0x76, 0x04, 0xf0, 0xa4, 0x50, 0x15, 0x64, 0x83, 0x41,
+	0xdf, 0xc3, 0x3e, 0xdb, 0x64, 0x3f, 0x6c, 0xd5, 0x04, 0xc7, 0x93, 0x32, 0xa5, 0x22, 0x5b, 0x48,
+	0xfc, 0x2d, 0x28, 0xc2, 0xc0, 0x5a, 0x98, 0x2c, 0x70, 0x56, 0x2b, 0xc7, 0x5b, 0xe4, 0xaa, 0xe3,
+	0x01, 0xec, 0x86, 0xdc, 0x48, 0x28, 0xa3, 0x41, 0xa2, 0x09, 0xfe, 0x2b, 0xbe, 0x06, 0xe6, 0xfa,
+	0x4a, 0x9f, 0x5b, 0xd7, 0xb4, 0xfc, 0xd5, 0x4c, 0x2f, 0xc6, 0x4e, 0xc1, 0xc5, 0x48, 0xa8, 0x33,
+	0x17, 0x03, 0x9f, 0xc5, 0x97, 0xe0, 0x08, 0x9a, 0xaf, 0x86, 0xda, 0x78, 0x36, 0xd4, 0xc6, 0x3d,
+	0x6d, 0xaa, 0x54, 0x10, 0x82, 0xc3, 0x71, 0xe7, 0xf2, 0x52, 0xeb, 0xcd, 0xc6, 0xc6, 0x6c, 0x64,
+	0x90, 0xb1, 0x22, 0xe1, 0x3f, 0x25, 0x50, 0xb2, 0x44, 0xa2, 0xde, 0x17, 0x20, 0x87, 0xf1, 0x3c,
+	0xa9, 0xf9, 0x49, 0x61, 0x08, 0x64, 0x83, 0x41, 0x3a, 0xec, 0xb3, 0x4c, 0xde, 0x62, 0x09, 0x3c,
+	0xcd, 0xaf, 0x42, 0xc6, 0x98, 0x6c, 0x41, 0xf1, 0x3f, 0x12, 0xdc, 0x13, 0xd2, 0x0a, 0xfd, 0xa8,
+	0x32, 0x1f, 0x6d, 0x28, 0x17, 0x50, 0x0b, 0xfc, 0x65, 0xd2, 0x50, 0xce, 0xf2, 0x85, 0x9a, 0x65,
+	0x53, 0x89, 0xbf, 0xa4, 0x44, 0x00, 0xd1, 0xa7, 0x20, 0x5f, 0xfb, 0xae, 0xbb, 0xf6, 0x1c, 0xf6,
+	0x4e, 0x64, 0x5f, 0x26, 0x9b, 0x05, 0xfc, 0x12, 0x6a, 0xdc, 0x96, 0xf7, 0x11, 0xdd, 0x34, 0xfa,
+	0x9d, 0xb1, 0xd6, 0x53, 0x2a, 0xe8, 0x00, 0xe4, 0xae, 0x31, 0x18, 0x4c, 0x86, 0xfa, 0xf8, 0xb5,
+	0x22, 0xf1, 0xbc, 0x8f, 0x88, 0x31, 0xd0, 0xcd, 0xee, 0xc4, 0x98, 0x98, 0x4a, 0x15, 0xcf, 0x01,
+	0x6d, 0xf9, 0xbc, 0x0c, 0xfc, 0xf5, 0x8a, 0xf7, 0xaf, 0xcc, 0x01, 0xc4, 0x18, 0x7d, 0x0d, 0xbb,
+	0x2b, 0x21, 0xfd, 0x28, 0x61, 0xcf, 0xca, 0xc5, 0x4f, 0x22, 0x10, 0x7e, 0x0e, 0x8f, 0x3e, 0xf4,
+	0x33, 0xe4, 0xbc, 0xb7, 0xf8, 0xc2, 0xaf, 0x6f, 0xb3, 0x8e, 0xcb, 0x5f, 0x5f, 0xf0, 0x49, 0x52,
+	0xfb, 0x2f, 0x8a, 0xc3, 0x10, 0x60, 0x12, 0xc3, 0x70, 0x13, 0x64, 0xbe, 0xab, 0xb9, 0x2b, 0xf6,
+	0x0e, 0xfb, 0xd1, 0x7b, 0x47, 0x68, 0xb8, 0x5e, 0x32, 0xf4, 0x0d, 0xd4, 0x03, 0x31, 0x6a, 0x49,
+	0x05, 0xd2, 0x8e, 0x00, 0x6a, 0xf4, 0x43, 0x62, 0x14, 0x3e, 0x81, 0x7a, 0xcc, 0x04, 0x50, 0xff,
+	0xae, 0xa3, 0xf7, 0x45, 0x05, 0x9a, 0xb0, 0x67, 0x4e, 0xba, 0x5d, 0xd1, 0xba, 0xce, 0xff, 0x68,
+	0xc2, 0xa1, 0x78, 0x95, 0xd2, 0xa7, 0x1a, 0xf5, 0x01, 0xba, 0x01, 0xb5, 0x18, 0xe5, 0xeb, 0xe8,
+	0x93, 0xfc, 0xf3, 0xd8, 0xc7, 0x8f, 0x0b, 0x02, 0xc2, 0x15, 0xce, 0xd6, 0xa3, 0x4b, 0x7a, 0x47,
+	0x6c, 0x26, 0x1c, 0x74, 0x6c, 0x3b, 0xf3, 0x49, 0x50, 0xf4, 0xce, 0x96, 0x21, 0x9d, 0x82, 0x42,
+	0xa8, 0xeb, 0xdf, 0xd0, 0x3b, 0xe6, 0x7d, 0x05, 0x7b, 0x26, 0x65, 0xe2, 0x3b, 0xe3, 0xe3, 0x0d,
+	0xbd, 0x5c, 0x1e, 0x65, 0xae, 0xb7, 0xa9, 0x78, 0x2e, 0x3e, 0xcf, 0xb5, 0x17, 0x32, 0x3a, 0xce,
+	0x77, 0xc7, 0x39, 0x70, 0x05, 0xfd, 0x0c, 0x47, 0x26, 0x65, 0x5b, 0xed, 0xba, 0x5c, 0xc3, 0x29,
+	0x57, 0xa4, 0x66, 0xc7, 0xb6, 0xd3, 0x46, 0x5e, 0xdc, 0x0d, 0xcb, 0x15, 0xe9, 0x30, 0xd2, 0xd1,
+	0x1d, 0xf3, 0xfe, 0x04, 0x07, 0x3c, 0x27, 0x66, 0xda, 0x8e, 0x8b, 0x72, 0xfb, 0xb4, 0xd0, 0x6d,
+	0x9c, 0x63, 0x13, 0x9a, 0x26, 0x65, 0xe9, 0x67, 0x5d, 0xf1, 0xd3, 0x5c, 0x26, 0xdc, 0x5f, 0x00,
+	0xc5, 0x17, 0x20, 0xfb, 0x21, 0x56, 0xea, 0xc9, 0x2e, 0x43, 0xff, 0x2b, 0x3c, 0xdc, 0xdc, 0xd6,
+	0xff, 0xc5, 0xc3, 0x0c, 0xee, 0x99, 0x94, 0xbd, 0xd7, 0xde, 0xcb, 0x36, 0xcd, 0x32, 0x0e, 0xe6,
+	0xf0, 0x20, 0x3a, 0xc2, 0x7b, 0x3e, 0xce, 0x4a, 0xfa, 0xe0, 0x6f, 0x40, 0x19, 0x3f, 0x36, 0xdc,
+	0xe7, 0x85, 0xde, 0x06, 0x17, 0xcb, 0xa7, 0x6c, 0x18, 0x91, 0x88, 0xae, 0xea, 0xe2, 0x8f, 0xd3,
+	0x8b, 0xff, 0x06, 0x00, 0x9e, 0x4f, 0x4c, 0x95, 0x51, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VlanManagementClient is the client API for VlanManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VlanManagementClient interface {
+	CreateVlan(ctx context.Context, in *VlanId, opts ...grpc.CallOption) (*VlanResult, error)
+	DeleteVlan(ctx context.Context, in *VlanId, opts ...grpc.CallOption) (*VlanResult, error)
+	// Adds port or LAG to VLAN, or changes tagging mode of existing member
+	AddVlanMember(ctx context.Context, in *VlanMember, opts ...grpc.CallOption) (*VlanResult, error)
+	RemoveVlanMember(ctx context.Context, in *VlanMember, opts ...grpc.CallOption) (*VlanResult, error)
+	SetPvid(ctx context.Context, in *VlanPvid, opts ...grpc.CallOption) (*VlanResult, error)
+	ListVlans(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanList, error)
+	// Tells if VLAN tag is stripped from control frames delivered to port netdev or to
+	// management interface
+	SetTagStripping(ctx context.Context, in *VlanTagStripping, opts ...grpc.CallOption) (*VlanResult, error)
+	AddSubIface(ctx context.Context, in *VlanSubIface, opts ...grpc.CallOption) (*VlanResult, error)
+	DeleteSubIface(ctx context.Context, in *VlanSubIface, opts ...grpc.CallOption) (*VlanResult, error)
+	ListSubIfaces(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanSubIfaceList, error)
+	SetQinqPort(ctx context.Context, in *VlanQinqPort, opts ...grpc.CallOption) (*VlanResult, error)
+	AddVlanTranslation(ctx context.Context, in *VlanTranslation, opts ...grpc.CallOption) (*VlanResult, error)
+	DeleteVlanTranslation(ctx context.Context, in *VlanTranslation, opts ...grpc.CallOption) (*VlanResult, error)
+	// Creates isolation group or replaces its ports
+	SetIsolationGroup(ctx context.Context, in *VlanIsolationGroup, opts ...grpc.CallOption) (*VlanResult, error)
+	DeleteIsolationGroup(ctx context.Context, in *VlanIsolationGroupName, opts ...grpc.CallOption) (*VlanResult, error)
+	ListIsolationGroups(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanIsolationGroupList, error)
+}
+
+type vlanManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVlanManagementClient(cc *grpc.ClientConn) VlanManagementClient {
+	return &vlanManagementClient{cc}
+}
+
+func (c *vlanManagementClient) CreateVlan(ctx context.Context, in *VlanId, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/CreateVlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) DeleteVlan(ctx context.Context, in *VlanId, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/DeleteVlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) AddVlanMember(ctx context.Context, in *VlanMember, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/AddVlanMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) RemoveVlanMember(ctx context.Context, in *VlanMember, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/RemoveVlanMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) SetPvid(ctx context.Context, in *VlanPvid, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/SetPvid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) ListVlans(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanList, error) {
+	out := new(VlanList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/ListVlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) SetTagStripping(ctx context.Context, in *VlanTagStripping, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/SetTagStripping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) AddSubIface(ctx context.Context, in *VlanSubIface, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/AddSubIface", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) DeleteSubIface(ctx context.Context, in *VlanSubIface, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/DeleteSubIface", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) ListSubIfaces(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanSubIfaceList, error) {
+	out := new(VlanSubIfaceList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/ListSubIfaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) SetQinqPort(ctx context.Context, in *VlanQinqPort, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/SetQinqPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) AddVlanTranslation(ctx context.Context, in *VlanTranslation, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/AddVlanTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) DeleteVlanTranslation(ctx context.Context, in *VlanTranslation, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/DeleteVlanTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) SetIsolationGroup(ctx context.Context, in *VlanIsolationGroup, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/SetIsolationGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) DeleteIsolationGroup(ctx context.Context, in *VlanIsolationGroupName, opts ...grpc.CallOption) (*VlanResult, error) {
+	out := new(VlanResult)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/DeleteIsolationGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vlanManagementClient) ListIsolationGroups(ctx context.Context, in *VlanEmpty, opts ...grpc.CallOption) (*VlanIsolationGroupList, error) {
+	out := new(VlanIsolationGroupList)
+	err := c.cc.Invoke(ctx, "/OpenNos.Switch.Vlan.VlanManagement/ListIsolationGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VlanManagementServer is the server API for VlanManagement service.
+type VlanManagementServer interface {
+	CreateVlan(context.Context, *VlanId) (*VlanResult, error)
+	DeleteVlan(context.Context, *VlanId) (*VlanResult, error)
+	// Adds port or LAG to VLAN, or changes tagging mode of existing member
+	AddVlanMember(context.Context, *VlanMember) (*VlanResult, error)
+	RemoveVlanMember(context.Context, *VlanMember) (*VlanResult, error)
+	SetPvid(context.Context, *VlanPvid) (*VlanResult, error)
+	ListVlans(context.Context, *VlanEmpty) (*VlanList, error)
+	// Tells if VLAN tag is stripped from control frames delivered to port netdev or to
+	// management interface
+	SetTagStripping(context.Context, *VlanTagStripping) (*VlanResult, error)
+	AddSubIface(context.Context, *VlanSubIface) (*VlanResult, error)
+	DeleteSubIface(context.Context, *VlanSubIface) (*VlanResult, error)
+	ListSubIfaces(context.Context, *VlanEmpty) (*VlanSubIfaceList, error)
+	SetQinqPort(context.Context, *VlanQinqPort) (*VlanResult, error)
+	AddVlanTranslation(context.Context, *VlanTranslation) (*VlanResult, error)
+	DeleteVlanTranslation(context.Context, *VlanTranslation) (*VlanResult, error)
+	// Creates isolation group or replaces its ports
+	SetIsolationGroup(context.Context, *VlanIsolationGroup) (*VlanResult, error)
+	DeleteIsolationGroup(context.Context, *VlanIsolationGroupName) (*VlanResult, error)
+	ListIsolationGroups(context.Context, *VlanEmpty) (*VlanIsolationGroupList, error)
+}
+
+// UnimplementedVlanManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedVlanManagementServer struct {
+}
+
+func (*UnimplementedVlanManagementServer) CreateVlan(ctx context.Context, req *VlanId) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVlan not implemented")
+}
+func (*UnimplementedVlanManagementServer) DeleteVlan(ctx context.Context, req *VlanId) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVlan not implemented")
+}
+func (*UnimplementedVlanManagementServer) AddVlanMember(ctx context.Context, req *VlanMember) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVlanMember not implemented")
+}
+func (*UnimplementedVlanManagementServer) RemoveVlanMember(ctx context.Context, req *VlanMember) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVlanMember not implemented")
+}
+func (*UnimplementedVlanManagementServer) SetPvid(ctx context.Context, req *VlanPvid) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPvid not implemented")
+}
+func (*UnimplementedVlanManagementServer) ListVlans(ctx context.Context, req *VlanEmpty) (*VlanList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVlans not implemented")
+}
+func (*UnimplementedVlanManagementServer) SetTagStripping(ctx context.Context, req *VlanTagStripping) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagStripping not implemented")
+}
+func (*UnimplementedVlanManagementServer) AddSubIface(ctx context.Context, req *VlanSubIface) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubIface not implemented")
+}
+func (*UnimplementedVlanManagementServer) DeleteSubIface(ctx context.Context, req *VlanSubIface) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubIface not implemented")
+}
+func (*UnimplementedVlanManagementServer) ListSubIfaces(ctx context.Context, req *VlanEmpty) (*VlanSubIfaceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubIfaces not implemented")
+}
+func (*UnimplementedVlanManagementServer) SetQinqPort(ctx context.Context, req *VlanQinqPort) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQinqPort not implemented")
+}
+func (*UnimplementedVlanManagementServer) AddVlanTranslation(ctx context.Context, req *VlanTranslation) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVlanTranslation not implemented")
+}
+func (*UnimplementedVlanManagementServer) DeleteVlanTranslation(ctx context.Context, req *VlanTranslation) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVlanTranslation not implemented")
+}
+func (*UnimplementedVlanManagementServer) SetIsolationGroup(ctx context.Context, req *VlanIsolationGroup) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsolationGroup not implemented")
+}
+func (*UnimplementedVlanManagementServer) DeleteIsolationGroup(ctx context.Context, req *VlanIsolationGroupName) (*VlanResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIsolationGroup not implemented")
+}
+func (*UnimplementedVlanManagementServer) ListIsolationGroups(ctx context.Context, req *VlanEmpty) (*VlanIsolationGroupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIsolationGroups not implemented")
+}
+
+func RegisterVlanManagementServer(s *grpc.Server, srv VlanManagementServer) {
+	s.RegisterService(&_VlanManagement_serviceDesc, srv)
+}
+
+func _VlanManagement_CreateVlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).CreateVlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/CreateVlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).CreateVlan(ctx, req.(*VlanId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_DeleteVlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).DeleteVlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/DeleteVlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).DeleteVlan(ctx, req.(*VlanId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_AddVlanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).AddVlanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/AddVlanMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).AddVlanMember(ctx, req.(*VlanMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_RemoveVlanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).RemoveVlanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/RemoveVlanMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).RemoveVlanMember(ctx, req.(*VlanMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_SetPvid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanPvid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).SetPvid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/SetPvid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).SetPvid(ctx, req.(*VlanPvid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_ListVlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).ListVlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/ListVlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).ListVlans(ctx, req.(*VlanEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_SetTagStripping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanTagStripping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).SetTagStripping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/SetTagStripping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).SetTagStripping(ctx, req.(*VlanTagStripping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_AddSubIface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanSubIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).AddSubIface(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/AddSubIface",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).AddSubIface(ctx, req.(*VlanSubIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_DeleteSubIface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanSubIface)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).DeleteSubIface(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/DeleteSubIface",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).DeleteSubIface(ctx, req.(*VlanSubIface))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_ListSubIfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).ListSubIfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/ListSubIfaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).ListSubIfaces(ctx, req.(*VlanEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_SetQinqPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanQinqPort)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).SetQinqPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/SetQinqPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).SetQinqPort(ctx, req.(*VlanQinqPort))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_AddVlanTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanTranslation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).AddVlanTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/AddVlanTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).AddVlanTranslation(ctx, req.(*VlanTranslation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_DeleteVlanTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanTranslation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).DeleteVlanTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/DeleteVlanTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).DeleteVlanTranslation(ctx, req.(*VlanTranslation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_SetIsolationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanIsolationGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).SetIsolationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/SetIsolationGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).SetIsolationGroup(ctx, req.(*VlanIsolationGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_DeleteIsolationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanIsolationGroupName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).DeleteIsolationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/DeleteIsolationGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).DeleteIsolationGroup(ctx, req.(*VlanIsolationGroupName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VlanManagement_ListIsolationGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanManagementServer).ListIsolationGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenNos.Switch.Vlan.VlanManagement/ListIsolationGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanManagementServer).ListIsolationGroups(ctx, req.(*VlanEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VlanManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OpenNos.Switch.Vlan.VlanManagement",
+	HandlerType: (*VlanManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVlan",
+			Handler:    _VlanManagement_CreateVlan_Handler,
+		},
+		{
+			MethodName: "DeleteVlan",
+			Handler:    _VlanManagement_DeleteVlan_Handler,
+		},
+		{
+			MethodName: "AddVlanMember",
+			Handler:    _VlanManagement_AddVlanMember_Handler,
+		},
+		{
+			MethodName: "RemoveVlanMember",
+			Handler:    _VlanManagement_RemoveVlanMember_Handler,
+		},
+		{
+			MethodName: "SetPvid",
+			Handler:    _VlanManagement_SetPvid_Handler,
+		},
+		{
+			MethodName: "ListVlans",
+			Handler:    _VlanManagement_ListVlans_Handler,
+		},
+		{
+			MethodName: "SetTagStripping",
+			Handler:    _VlanManagement_SetTagStripping_Handler,
+		},
+		{
+			MethodName: "AddSubIface",
+			Handler:    _VlanManagement_AddSubIface_Handler,
+		},
+		{
+			MethodName: "DeleteSubIface",
+			Handler:    _VlanManagement_DeleteSubIface_Handler,
+		},
+		{
+			MethodName: "ListSubIfaces",
+			Handler:    _VlanManagement_ListSubIfaces_Handler,
+		},
+		{
+			MethodName: "SetQinqPort",
+			Handler:    _VlanManagement_SetQinqPort_Handler,
+		},
+		{
+			MethodName: "AddVlanTranslation",
+			Handler:    _VlanManagement_AddVlanTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteVlanTranslation",
+			Handler:    _VlanManagement_DeleteVlanTranslation_Handler,
+		},
+		{
+			MethodName: "SetIsolationGroup",
+			Handler:    _VlanManagement_SetIsolationGroup_Handler,
+		},
+		{
+			MethodName: "DeleteIsolationGroup",
+			Handler:    _VlanManagement_DeleteIsolationGroup_Handler,
+		},
+		{
+			MethodName: "ListIsolationGroups",
+			Handler:    _VlanManagement_ListIsolationGroups_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vlan_management.proto",
+}
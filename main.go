@@ -2,6 +2,7 @@ package main
 
 import (
 	bcm "bcm-eth-switch-mgmt/switch"
+	"flag"
 	"fmt"
 	"net"
 	"os"
@@ -23,6 +24,14 @@ func watchSignal(done chan struct{}) {
 }
 
 func main() {
+	kernelFdbSync := flag.Bool("kernel-fdb-sync", false, "Mirror hardware learned MAC addresses into kernel FDB")
+	lagSync := flag.Bool("lag-sync", false, "Create LAGs following kernel team and bond devices")
+	netdevSync := flag.Bool("netdev-sync", false, "Apply admin state and MTU of port netdevs to ports")
+	statsInterval := flag.Duration("stats-interval", bcm.DEFAULT_STATS_INTERVAL, "Interval of polling port counters, zero disables polling")
+	metricsAddr := flag.String("metrics-addr", "", "Address to export Prometheus metrics at, e.g. :9273")
+	bridgeSync := flag.String("bridge-sync", "", "Mirror VLANs, port states and static FDB of given Linux bridge into the switch")
+	flag.Parse()
+
 	log.SetLevel(log.DebugLevel)
 	sw := bcm.NewSwitch()
 	if err := sw.Init(); err != nil {
@@ -49,6 +58,8 @@ func main() {
 		return
 	}
 
+	sw.AddMgmtIface(mgmtIface)
+
 	l2Ports := make(map[string]*bcm.L2Port)
 	var idx uint16 = 0
 	// for _, namePortMap := range bcm.NamePortMap {
@@ -75,6 +86,7 @@ func main() {
 		}
 
 		l2Ports[portNameMap.PortName] = l2Port
+		sw.AddL2Port(l2Port)
 		idx++
 	}
 
@@ -85,8 +97,58 @@ func main() {
 	}
 
 	defer rx.Stop()
+	if err := sw.RegisterRxHandlers(rx); err != nil {
+		log.Errorf("Failed to register handlers of received data: %s", err)
+		return
+	}
+
+	if err := sw.RegisterL2AddrCallback(); err != nil {
+		log.Errorf("Failed to register for L2 address notifications: %s", err)
+		return
+	}
+
+	sw.RestoreConfig()
+
+	if err := sw.StartLinkscan(); err != nil {
+		log.Errorf("Failed to start linkscan: %s", err)
+		return
+	}
+
+	if *statsInterval > 0 {
+		sw.StartPortStats(*statsInterval)
+	}
+
+	if *kernelFdbSync {
+		sw.StartKernelFdbSync()
+	}
+
+	if *lagSync {
+		sw.StartLagSync()
+	}
+
+	if *netdevSync {
+		sw.StartNetdevSync()
+	}
+
+	if len(*bridgeSync) > 0 {
+		if err := sw.StartBridgeSync(*bridgeSync); err != nil {
+			log.Errorf("Failed to start sync of bridge %s: %s", *bridgeSync, err)
+			return
+		}
+	}
+
+	if len(*metricsAddr) > 0 {
+		sw.StartMetrics(*metricsAddr)
+	}
+
 	go bcm.HandleSTPRequest(sw)
 	go bcm.HandleLAGRequest(sw)
+	go bcm.HandleBpduRequest(sw)
+	go bcm.HandleFdbRequest(sw)
+	go bcm.HandlePortSecurityRequest(sw)
+	go bcm.HandleVlanRequest(sw)
+	go bcm.HandlePortRequest(sw)
+	go bcm.HandleMirrorRequest(sw)
 
 	if err := sal.DriverShell(); err != nil {
 		log.Errorf("Failed to exit from driver shell: %s", err)
@@ -0,0 +1,236 @@
+package bcm
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Zero age timer disables ageing of L2 addresses
+	L2_AGE_TIMER_DISABLED = 0
+	// Ageing time used to search limits of the chip when ageing is disabled
+	DEFAULT_AGEING_TIME = 300
+)
+
+// ageingLimits returns range of age timer in seconds accepted by the chip. SDK does not
+// report it, so it is found on first use by setting the age timer, after which the value
+// programmed before is restored. It has to be called with ageing locked.
+func (sw *Switch) ageingLimits() (uint32, uint32, error) {
+	if sw.ageing.limitsKnown {
+		return sw.ageing.minAgeingTime, sw.ageing.maxAgeingTime, nil
+	}
+
+	current, err := opennsl.L2AddrAgeTimerGet(sw.asic.unit)
+	if err != nil {
+		log.Errorf("Failed to get ageing of L2 address: %s", err)
+		return 0, 0, err
+	}
+
+	accepted := func(ageingTime uint32) bool {
+		return opennsl.L2AddrAgeTimerSet(sw.asic.unit, int(ageingTime)) == nil
+	}
+
+	// Search starts from a value accepted by the chip, expecting all values between the limits
+	// to be accepted as well
+	anchor := uint32(current)
+	if anchor == L2_AGE_TIMER_DISABLED {
+		anchor = DEFAULT_AGEING_TIME
+	}
+
+	var minAgeingTime, maxAgeingTime uint32
+	if accepted(anchor) {
+		maxAgeingTime = anchor
+		for low, high := anchor+1, uint32(math.MaxInt32); low <= high; {
+			middle := low + (high-low)/2
+			if accepted(middle) {
+				maxAgeingTime = middle
+				low = middle + 1
+			} else {
+				high = middle - 1
+			}
+		}
+
+		minAgeingTime = anchor
+		for low, high := uint32(1), anchor-1; low <= high; {
+			middle := low + (high-low)/2
+			if accepted(middle) {
+				minAgeingTime = middle
+				high = middle - 1
+			} else {
+				low = middle + 1
+			}
+		}
+	}
+
+	if err := opennsl.L2AddrAgeTimerSet(sw.asic.unit, current); err != nil {
+		log.Errorf("Failed to restore ageing of L2 address (%d): %s", current, err)
+		return 0, 0, err
+	}
+
+	if maxAgeingTime == 0 {
+		return 0, 0, fmt.Errorf("Chip does not accept any ageing time")
+	}
+
+	log.Infof("Ageing time accepted by the chip is <%d, %d> s", minAgeingTime, maxAgeingTime)
+	sw.ageing.minAgeingTime = minAgeingTime
+	sw.ageing.maxAgeingTime = maxAgeingTime
+	sw.ageing.limitsKnown = true
+	return minAgeingTime, maxAgeingTime, nil
+}
+
+// validateAgeingTime checks ageing time against limits of the chip. It has to be called with
+// ageing locked.
+func (sw *Switch) validateAgeingTime(ageingTime uint32) error {
+	if ageingTime == L2_AGE_TIMER_DISABLED {
+		return nil
+	}
+
+	minAgeingTime, maxAgeingTime, err := sw.ageingLimits()
+	if err != nil {
+		return err
+	}
+
+	if ageingTime < minAgeingTime || ageingTime > maxAgeingTime {
+		return fmt.Errorf("Ageing time %d s is out of range <%d, %d> supported by the chip", ageingTime, minAgeingTime, maxAgeingTime)
+	}
+
+	return nil
+}
+
+func (sw *Switch) setHwAgeingTime(ageingTime uint32) error {
+	if ageingTime == L2_AGE_TIMER_DISABLED {
+		log.Infof("Disabling ageing of L2 addresses")
+	}
+
+	if err := opennsl.L2AddrAgeTimerSet(sw.asic.unit, int(ageingTime)); err != nil {
+		log.Errorf("Failed to set ageing of L2 address (%d): %s", ageingTime, err)
+		return err
+	}
+
+	return nil
+}
+
+// configuredAgeingTime returns ageing time which is used out of fast ageing. Until it is set,
+// the value programmed in hardware is taken.
+func (sw *Switch) configuredAgeingTime() (uint32, error) {
+	if sw.ageing.configured {
+		return sw.ageing.ageingTime, nil
+	}
+
+	ageingTime, err := opennsl.L2AddrAgeTimerGet(sw.asic.unit)
+	if err != nil {
+		log.Errorf("Failed to get ageing of L2 address: %s", err)
+		return 0, err
+	}
+
+	sw.ageing.ageingTime = uint32(ageingTime)
+	sw.ageing.configured = true
+	return sw.ageing.ageingTime, nil
+}
+
+// SetAgeingTime sets ageing time of L2 addresses. During fast ageing it only becomes
+// the value restored when fast ageing ends.
+func (sw *Switch) SetAgeingTime(ageingTime uint32) error {
+	sw.ageing.mtx.Lock()
+	defer sw.ageing.mtx.Unlock()
+
+	if err := sw.validateAgeingTime(ageingTime); err != nil {
+		return err
+	}
+
+	if sw.ageing.fastAgeingTimer == nil {
+		if err := sw.setHwAgeingTime(ageingTime); err != nil {
+			return err
+		}
+	} else {
+		log.Infof("Fast ageing is active, ageing time %d s will be set when it ends", ageingTime)
+	}
+
+	sw.ageing.ageingTime = ageingTime
+	sw.ageing.configured = true
+	return nil
+}
+
+// StartFastAgeing temporarily shortens ageing time, e.g. for the period of STP topology change.
+// Starting fast ageing again while it is active extends it.
+func (sw *Switch) StartFastAgeing(ageingTime uint32, duration time.Duration) error {
+	if ageingTime == L2_AGE_TIMER_DISABLED {
+		return fmt.Errorf("Fast ageing cannot disable ageing")
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("Duration of fast ageing has to be positive")
+	}
+
+	sw.ageing.mtx.Lock()
+	defer sw.ageing.mtx.Unlock()
+
+	if err := sw.validateAgeingTime(ageingTime); err != nil {
+		return err
+	}
+
+	if _, err := sw.configuredAgeingTime(); err != nil {
+		return err
+	}
+
+	if err := sw.setHwAgeingTime(ageingTime); err != nil {
+		return err
+	}
+
+	if sw.ageing.fastAgeingTimer != nil {
+		sw.ageing.fastAgeingTimer.Stop()
+	}
+
+	log.Infof("Fast ageing with ageing time %d s for %s", ageingTime, duration)
+	sw.ageing.fastAgeingTime = ageingTime
+	sw.ageing.fastAgeingUntil = time.Now().Add(duration)
+	sw.ageing.fastAgeingTimer = time.AfterFunc(duration, sw.stopFastAgeing)
+	return nil
+}
+
+func (sw *Switch) stopFastAgeing() {
+	sw.ageing.mtx.Lock()
+	defer sw.ageing.mtx.Unlock()
+
+	if sw.ageing.fastAgeingTimer == nil || time.Now().Before(sw.ageing.fastAgeingUntil) {
+		// Fast ageing has been extended in the meantime
+		return
+	}
+
+	sw.ageing.fastAgeingTimer = nil
+	log.Infof("Fast ageing ended, restoring ageing time %d s", sw.ageing.ageingTime)
+	if err := sw.setHwAgeingTime(sw.ageing.ageingTime); err != nil {
+		log.Errorf("Failed to restore ageing time after fast ageing: %s", err)
+	}
+}
+
+// AgeingState describes current ageing of L2 addresses.
+type AgeingState struct {
+	AgeingTime       uint32
+	ActiveAgeingTime uint32
+	FastAgeing       bool
+	FastAgeingLeft   time.Duration
+}
+
+func (sw *Switch) AgeingState() (*AgeingState, error) {
+	sw.ageing.mtx.Lock()
+	defer sw.ageing.mtx.Unlock()
+
+	ageingTime, err := sw.configuredAgeingTime()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &AgeingState{AgeingTime: ageingTime, ActiveAgeingTime: ageingTime}
+	if sw.ageing.fastAgeingTimer != nil {
+		state.FastAgeing = true
+		state.ActiveAgeingTime = sw.ageing.fastAgeingTime
+		state.FastAgeingLeft = time.Until(sw.ageing.fastAgeingUntil)
+	}
+
+	return state, nil
+}
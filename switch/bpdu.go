@@ -0,0 +1,240 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchBpdu/gRPCServices"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	bpduMgmtPort            = ":50053"
+	BPDU_GUARD_REASON       = "BPDU guard"
+	RX_PRIO_BPDU_PROTECTION = 100
+	RX_NAME_BPDU_PROTECTION = "BPDU protection"
+)
+
+// BpduProtectionConfig represents BPDU guard and BPDU filter settings of port.
+type BpduProtectionConfig struct {
+	Guard           bool   `json:"guard"`
+	Filter          bool   `json:"filter"`
+	AutoRecoverySec uint32 `json:"autoRecoverySec"`
+}
+
+// knetDest returns where BPDUs received on port have to be delivered. BPDU filter drops them,
+// BPDU guard passes them to the daemon instead of the port netdev.
+func (cfg BpduProtectionConfig) knetDest() opennsl.KnetDestType {
+	if cfg.Filter {
+		return opennsl.KNET_DEST_T_NULL
+	}
+
+	if cfg.Guard {
+		return opennsl.KNET_DEST_T_BCM_RX_API
+	}
+
+	return opennsl.KNET_DEST_T_NETIF
+}
+
+type bpduProtection struct {
+	sw         *Switch
+	mtx        sync.Mutex
+	violations map[string]uint64
+}
+
+func newBpduProtection(sw *Switch) *bpduProtection {
+	return &bpduProtection{
+		sw:         sw,
+		violations: make(map[string]uint64),
+	}
+}
+
+func (bpdu *bpduProtection) config(portName string) BpduProtectionConfig {
+	var cfg BpduProtectionConfig
+	bpdu.sw.cfg.view(func(c *Config) {
+		cfg = c.BpduProtection[portName]
+	})
+
+	return cfg
+}
+
+func (bpdu *bpduProtection) apply(portName string, cfg BpduProtectionConfig) error {
+	l2Port, exists := bpdu.sw.l2Ports[portName]
+	if !exists {
+		return fmt.Errorf("L2 port %s does not exist", portName)
+	}
+
+	return l2Port.SetBpduDestination(cfg.knetDest())
+}
+
+func (bpdu *bpduProtection) set(portName string, cfg BpduProtectionConfig) error {
+	if err := bpdu.apply(portName, cfg); err != nil {
+		log.Errorf("Failed to apply BPDU protection on port %s: %s", portName, err)
+		return err
+	}
+
+	return bpdu.sw.cfg.update(func(c *Config) {
+		if cfg == (BpduProtectionConfig{}) {
+			delete(c.BpduProtection, portName)
+		} else {
+			c.BpduProtection[portName] = cfg
+		}
+	})
+}
+
+func (bpdu *bpduProtection) restore() error {
+	configs := make(map[string]BpduProtectionConfig)
+	bpdu.sw.cfg.view(func(c *Config) {
+		for portName, cfg := range c.BpduProtection {
+			configs[portName] = cfg
+		}
+	})
+
+	for portName, cfg := range configs {
+		log.Infof("Restoring BPDU protection on port %s: guard %t, filter %t", portName, cfg.Guard, cfg.Filter)
+		if err := bpdu.apply(portName, cfg); err != nil {
+			log.Errorf("Failed to restore BPDU protection on port %s: %s", portName, err)
+		}
+	}
+
+	return nil
+}
+
+// handleRxPacket receives BPDUs which KNET passes to the daemon from ports with BPDU guard enabled.
+func (bpdu *bpduProtection) handleRxPacket(unit int, pkt *opennsl.Pkt) opennsl.RxResult {
+	if !pkt.RxReasons().Has(opennsl.RxReasonBpdu) {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	portName, err := portNameOf(pkt.SrcPort())
+	if err != nil {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	cfg := bpdu.config(portName)
+	if !cfg.Guard || cfg.Filter {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	bpdu.mtx.Lock()
+	bpdu.violations[portName]++
+	bpdu.mtx.Unlock()
+
+	log.Warnf("BPDU received on port %s with BPDU guard enabled", portName)
+	// Do not reconfigure the port from within the Rx thread
+	go func() {
+		autoRecovery := time.Duration(cfg.AutoRecoverySec) * time.Second
+		if err := bpdu.sw.ErrDisablePort(portName, BPDU_GUARD_REASON, autoRecovery); err != nil {
+			log.Errorf("Failed to error-disable port %s on BPDU guard violation: %s", portName, err)
+		}
+	}()
+
+	return opennsl.RX_HANDLED
+}
+
+type bpduRequestMgmt struct {
+	pb.UnimplementedBpduProtectionServer
+	sw *Switch
+}
+
+func (bpduMgmt *bpduRequestMgmt) SetBpduProtection(ctx context.Context, req *pb.BpduProtectionConfig) (*pb.BpduResult, error) {
+	portName := req.GetInterface().GetIfname()
+	log.Infof("SetBpduProtection: Ifname %s, guard %t, filter %t, auto-recovery %d s",
+		portName, req.GetGuard(), req.GetFilter(), req.GetAutoRecoverySec())
+	if _, err := bpduMgmt.sw.ResolvePort(portName); err != nil {
+		log.Errorf("%s", err)
+		return &pb.BpduResult{Result: pb.BpduResult_FAILED}, err
+	}
+
+	cfg := BpduProtectionConfig{
+		Guard:           req.GetGuard(),
+		Filter:          req.GetFilter(),
+		AutoRecoverySec: req.GetAutoRecoverySec(),
+	}
+
+	if err := bpduMgmt.sw.bpduProtection.set(portName, cfg); err != nil {
+		return &pb.BpduResult{Result: pb.BpduResult_FAILED}, fmt.Errorf("Failed to set BPDU protection on port %s: %s", portName, err)
+	}
+
+	return &pb.BpduResult{Result: pb.BpduResult_SUCCESS}, nil
+}
+
+func (bpduMgmt *bpduRequestMgmt) GetBpduProtection(ctx context.Context, req *pb.BpduIface) (*pb.BpduProtectionStatus, error) {
+	portName := req.GetIfname()
+	if _, err := bpduMgmt.sw.ResolvePort(portName); err != nil {
+		log.Errorf("%s", err)
+		return nil, err
+	}
+
+	bpdu := bpduMgmt.sw.bpduProtection
+	cfg := bpdu.config(portName)
+	bpdu.mtx.Lock()
+	violations := bpdu.violations[portName]
+	bpdu.mtx.Unlock()
+
+	return &pb.BpduProtectionStatus{
+		Config: &pb.BpduProtectionConfig{
+			Interface:       &pb.BpduIface{Ifname: portName},
+			Guard:           cfg.Guard,
+			Filter:          cfg.Filter,
+			AutoRecoverySec: cfg.AutoRecoverySec,
+		},
+		ErrDisabled:     bpduMgmt.sw.IsErrDisabled(portName),
+		GuardViolations: violations,
+	}, nil
+}
+
+func (bpduMgmt *bpduRequestMgmt) RecoverPort(ctx context.Context, req *pb.BpduIface) (*pb.BpduResult, error) {
+	portName := req.GetIfname()
+	log.Infof("RecoverPort: Ifname %s", portName)
+	if err := bpduMgmt.sw.RecoverErrDisabledPort(portName); err != nil {
+		log.Errorf("Failed to recover port %s: %s", portName, err)
+		return &pb.BpduResult{Result: pb.BpduResult_FAILED}, err
+	}
+
+	return &pb.BpduResult{Result: pb.BpduResult_SUCCESS}, nil
+}
+
+// WatchErrDisableEvents streams error-disable events of given port or of all ports if name is empty.
+func (bpduMgmt *bpduRequestMgmt) WatchErrDisableEvents(req *pb.BpduIface, stream pb.BpduProtection_WatchErrDisableEventsServer) error {
+	portName := req.GetIfname()
+	events := bpduMgmt.sw.errDisableEvents.subscribe()
+	defer bpduMgmt.sw.errDisableEvents.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev := <-events:
+			event := ev.(*ErrDisableEvent)
+			if len(portName) > 0 && portName != event.PortName {
+				continue
+			}
+
+			if err := stream.Send(&pb.ErrDisableEvent{
+				Ifname:      event.PortName,
+				Reason:      event.Reason,
+				ErrDisabled: event.ErrDisabled,
+				Timestamp:   event.Time.Unix(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func HandleBpduRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", bpduMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterBpduProtectionServer(s, &bpduRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
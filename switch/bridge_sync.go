@@ -0,0 +1,392 @@
+package bcm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	BRIDGE_SYNC_INTERVAL = 30 * time.Second
+)
+
+// Port states of Linux bridge, see include/uapi/linux/if_bridge.h
+const (
+	BR_STATE_DISABLED = iota
+	BR_STATE_LISTENING
+	BR_STATE_LEARNING
+	BR_STATE_FORWARDING
+	BR_STATE_BLOCKING
+)
+
+var bridgePortStates = map[uint8]opennsl.StgStp{
+	BR_STATE_DISABLED:   opennsl.STG_STP_DISABLE,
+	BR_STATE_LISTENING:  opennsl.STG_STP_LISTEN,
+	BR_STATE_LEARNING:   opennsl.STG_STP_LEARN,
+	BR_STATE_FORWARDING: opennsl.STG_STP_FORWARD,
+	BR_STATE_BLOCKING:   opennsl.STG_STP_BLOCK,
+}
+
+// bridgePort represents state of kernel bridge port already programmed into hardware.
+type bridgePort struct {
+	vlans map[opennsl.Vlan]VlanMember
+	pvid  opennsl.Vlan
+	state uint8
+}
+
+// bridgeSync mirrors Linux VLAN-filtering bridge, whose ports are netdevs of switch ports
+// or LAGs, into hardware: VLAN membership, PVID, port STP state and static FDB entries.
+// VLAN membership and PVID configured through VLAN service and STP states set over gRPC take
+// precedence over the bridge.
+type bridgeSync struct {
+	sw      *Switch
+	bridge  string
+	ports   map[string]*bridgePort
+	fdb     map[string]StaticFdbEntry
+	trigger netlinkTrigger
+}
+
+func newBridgeSync(sw *Switch, bridge string) *bridgeSync {
+	return &bridgeSync{
+		sw:      sw,
+		bridge:  bridge,
+		ports:   make(map[string]*bridgePort),
+		fdb:     make(map[string]StaticFdbEntry),
+		trigger: newNetlinkTrigger(),
+	}
+}
+
+// dumpPortStates returns STP state of bridge ports indexed by netdev name.
+func (bs *bridgeSync) dumpPortStates(bridgeIndex int) (map[string]uint8, map[int]string, error) {
+	req := nl.NewNetlinkRequest(unix.RTM_GETLINK, unix.NLM_F_DUMP)
+	req.AddData(nl.NewIfInfomsg(unix.AF_BRIDGE))
+	msgs, err := req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWLINK)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	states := make(map[string]uint8)
+	names := make(map[int]string)
+	for _, m := range msgs {
+		ifInfo := nl.DeserializeIfInfomsg(m)
+		attrs, err := nl.ParseRouteAttr(m[ifInfo.Len():])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ifname := ""
+		master := 0
+		state := uint8(BR_STATE_FORWARDING)
+		for _, attr := range attrs {
+			switch attr.Attr.Type {
+			case unix.IFLA_IFNAME:
+				ifname = string(attr.Value[:len(attr.Value)-1])
+			case unix.IFLA_MASTER:
+				master = int(nl.NativeEndian().Uint32(attr.Value[0:4]))
+			case unix.IFLA_PROTINFO, unix.IFLA_PROTINFO | unix.NLA_F_NESTED:
+				brportAttrs, err := nl.ParseRouteAttr(attr.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				for _, brportAttr := range brportAttrs {
+					if brportAttr.Attr.Type == nl.IFLA_BRPORT_STATE {
+						state = brportAttr.Value[0]
+					}
+				}
+			}
+		}
+
+		if master != bridgeIndex || len(ifname) == 0 {
+			continue
+		}
+
+		states[ifname] = state
+		names[int(ifInfo.Index)] = ifname
+	}
+
+	return states, names, nil
+}
+
+// setStpState mirrors STP state of bridge port. States set over gRPC take precedence, so ports
+// whose state has been set by mstpd are left untouched.
+func (bs *bridgeSync) setStpState(iface *LogicalIface, ports []opennsl.Port, state opennsl.StgStp) error {
+	stg, err := opennsl.StpDefaultGet(bs.sw.asic.unit)
+	if err != nil {
+		return err
+	}
+
+	bs.sw.stpMtx.Lock()
+	defer bs.sw.stpMtx.Unlock()
+
+	for i, port := range ports {
+		portName := iface.Members[i]
+		if _, exists := bs.sw.stpRpcPorts[portName]; exists {
+			log.Debugf("STP state of port %s is set over gRPC, ignoring state of bridge %s", portName, bs.bridge)
+			continue
+		}
+
+		change := stpPortState{portName: portName, port: port, stg: stg, state: state}
+		if err := bs.sw.applyStpPortState(change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncPort programs difference between bridge port state in kernel and in hardware.
+func (bs *bridgeSync) syncPort(ifname string, desired *bridgePort) error {
+	iface, err := bs.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	current, exists := bs.ports[ifname]
+	if !exists {
+		current = &bridgePort{
+			vlans: make(map[opennsl.Vlan]VlanMember),
+			pvid:  opennsl.VLAN_ID_NONE,
+			state: BR_STATE_FORWARDING,
+		}
+		bs.ports[ifname] = current
+	}
+
+	for vid := range current.vlans {
+		if _, exists := desired.vlans[vid]; exists {
+			continue
+		}
+
+		if err := bs.sw.vlans.removeKernelMember(vid, ifname, ports); err != nil {
+			return fmt.Errorf("Failed to remove %s from VLAN %d: %s", ifname, vid, err)
+		}
+
+		delete(current.vlans, vid)
+	}
+
+	for vid, member := range desired.vlans {
+		if prevMember, exists := current.vlans[vid]; exists && prevMember == member {
+			continue
+		}
+
+		if err := bs.sw.vlans.addKernelMember(vid, ifname, ports, member.Untagged); err != nil {
+			return fmt.Errorf("Failed to add %s to VLAN %d: %s", ifname, vid, err)
+		}
+
+		current.vlans[vid] = member
+	}
+
+	if desired.pvid != current.pvid {
+		// Without PVID kernel drops untagged frames, hardware classifies them to default VLAN
+		pvid := desired.pvid
+		if pvid == opennsl.VLAN_ID_NONE {
+			pvid = opennsl.VLAN_ID_DEFAULT
+		}
+
+		if err := bs.sw.vlans.setKernelPvid(ifname, ports, pvid); err != nil {
+			return fmt.Errorf("Failed to set PVID %d of %s: %s", pvid, ifname, err)
+		}
+
+		current.pvid = desired.pvid
+	}
+
+	if desired.state != current.state {
+		stpState, exists := bridgePortStates[desired.state]
+		if !exists {
+			return fmt.Errorf("Unknown bridge port state %d of %s", desired.state, ifname)
+		}
+
+		if err := bs.setStpState(iface, ports, stpState); err != nil {
+			return fmt.Errorf("Failed to set STP state of %s: %s", ifname, err)
+		}
+
+		log.Infof("Bridge port %s changed state to %d", ifname, desired.state)
+		current.state = desired.state
+	}
+
+	return nil
+}
+
+func (bs *bridgeSync) syncFdb(names map[int]string) error {
+	neighs, err := netlink.NeighList(0, unix.AF_BRIDGE)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]StaticFdbEntry)
+	for _, neigh := range neighs {
+		ifname, exists := names[neigh.LinkIndex]
+		// Only entries added as static are mirrored. Permanent entries are addresses of
+		// bridge ports, while externally learned ones come from hardware itself.
+		if !exists || neigh.State&netlink.NUD_NOARP == 0 || neigh.Flags&unix.NTF_EXT_LEARNED != 0 || len(neigh.HardwareAddr) != 6 {
+			continue
+		}
+
+		vlan := opennsl.Vlan(neigh.Vlan)
+		if vlan == opennsl.VLAN_ID_NONE {
+			vlan = opennsl.VLAN_ID_DEFAULT
+		}
+
+		entry := StaticFdbEntry{MAC: neigh.HardwareAddr.String(), Vlan: uint16(vlan), Ifname: ifname}
+		desired[entry.key()] = entry
+	}
+
+	for key, entry := range bs.fdb {
+		if desiredEntry, exists := desired[key]; exists && desiredEntry == entry {
+			continue
+		}
+
+		if err := bs.sw.fdb.deleteKernelEntry(entry); err != nil {
+			log.Warnf("Failed to remove static entry %s of bridge %s: %s", key, bs.bridge, err)
+		}
+
+		delete(bs.fdb, key)
+	}
+
+	for key, entry := range desired {
+		if _, exists := bs.fdb[key]; exists {
+			continue
+		}
+
+		if err := bs.sw.fdb.installKernelEntry(entry); err != nil {
+			log.Warnf("Failed to install static entry %s of bridge %s: %s", key, bs.bridge, err)
+			continue
+		}
+
+		bs.fdb[key] = entry
+	}
+
+	return nil
+}
+
+// sync reads the whole bridge configuration from kernel and applies changes.
+func (bs *bridgeSync) sync() error {
+	bridge, err := netlink.LinkByName(bs.bridge)
+	if err != nil {
+		return fmt.Errorf("Bridge %s not found: %s", bs.bridge, err)
+	}
+
+	states, names, err := bs.dumpPortStates(bridge.Attrs().Index)
+	if err != nil {
+		return fmt.Errorf("Failed to dump ports of bridge %s: %s", bs.bridge, err)
+	}
+
+	vlanInfos, err := netlink.BridgeVlanList()
+	if err != nil {
+		return fmt.Errorf("Failed to dump VLANs of bridge %s: %s", bs.bridge, err)
+	}
+
+	prevVlans := make(map[opennsl.Vlan]struct{})
+	for _, current := range bs.ports {
+		for vid := range current.vlans {
+			prevVlans[vid] = struct{}{}
+		}
+	}
+
+	for ifindex, ifname := range names {
+		desired := &bridgePort{
+			vlans: make(map[opennsl.Vlan]VlanMember),
+			pvid:  opennsl.VLAN_ID_NONE,
+			state: states[ifname],
+		}
+
+		for _, vlanInfo := range vlanInfos[int32(ifindex)] {
+			vid := opennsl.Vlan(vlanInfo.Vid)
+			desired.vlans[vid] = VlanMember{Untagged: vlanInfo.EngressUntag()}
+			if vlanInfo.PortVID() {
+				desired.pvid = vid
+			}
+		}
+
+		if err := bs.syncPort(ifname, desired); err != nil {
+			log.Warnf("Failed to sync port %s of bridge %s: %s", ifname, bs.bridge, err)
+		}
+	}
+
+	// Ports which left the bridge forward again without any bridge VLAN
+	for ifname := range bs.ports {
+		if _, exists := states[ifname]; exists {
+			continue
+		}
+
+		left := &bridgePort{
+			vlans: make(map[opennsl.Vlan]VlanMember),
+			pvid:  opennsl.VLAN_ID_NONE,
+			state: BR_STATE_FORWARDING,
+		}
+
+		if err := bs.syncPort(ifname, left); err != nil {
+			log.Warnf("Failed to sync port %s which left bridge %s: %s", ifname, bs.bridge, err)
+			continue
+		}
+
+		log.Infof("Port %s left bridge %s", ifname, bs.bridge)
+		delete(bs.ports, ifname)
+	}
+
+	for vid := range prevVlans {
+		stillUsed := false
+		for _, current := range bs.ports {
+			if _, exists := current.vlans[vid]; exists {
+				stillUsed = true
+				break
+			}
+		}
+
+		if !stillUsed {
+			if err := bs.sw.vlans.releaseKernelVlan(vid); err != nil {
+				log.Warnf("Failed to destroy VLAN %d not used by bridge %s: %s", vid, bs.bridge, err)
+			}
+		}
+	}
+
+	return bs.syncFdb(names)
+}
+
+func (bs *bridgeSync) watchNeighs() {
+	updates := make(chan netlink.NeighUpdate)
+	if err := netlink.NeighSubscribe(updates, nil); err != nil {
+		log.Errorf("Failed to subscribe for FDB notifications: %s", err)
+		return
+	}
+
+	for update := range updates {
+		if update.Family == unix.AF_BRIDGE && update.Flags&unix.NTF_EXT_LEARNED == 0 {
+			bs.trigger.notify()
+		}
+	}
+}
+
+func (bs *bridgeSync) run() {
+	bs.trigger.run(BRIDGE_SYNC_INTERVAL, func() {
+		if err := bs.sync(); err != nil {
+			log.Errorf("%s", err)
+		}
+	})
+}
+
+// StartBridgeSync starts mirroring of Linux VLAN-filtering bridge into hardware, so the switch
+// can be configured with standard ip and bridge tools.
+func (sw *Switch) StartBridgeSync(bridge string) error {
+	if _, err := netlink.LinkByName(bridge); err != nil {
+		log.Errorf("Bridge %s not found: %s", bridge, err)
+		return err
+	}
+
+	log.Infof("Starting sync of bridge %s into hardware", bridge)
+	bs := newBridgeSync(sw, bridge)
+	go watchLinkChanges(bs.trigger.notify)
+	go bs.watchNeighs()
+	go bs.run()
+	return nil
+}
@@ -0,0 +1,182 @@
+package bcm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_CONFIG_FILE = "/etc/bcm-eth-switch-mgmt/config.json"
+)
+
+// Config represents switch settings which are preserved between daemon restarts.
+type Config struct {
+	BpduProtection   map[string]BpduProtectionConfig `json:"bpduProtection,omitempty"`
+	LearningPolicy   map[string]LearningPolicy       `json:"learningPolicy,omitempty"`
+	StaticFdb        map[string]StaticFdbEntry       `json:"staticFdb,omitempty"`
+	PortSecurity     map[string]PortSecurityConfig   `json:"portSecurity,omitempty"`
+	MacFlapDetection *MacFlapDetectionConfig         `json:"macFlapDetection,omitempty"`
+	MacFlapActions   map[string]MacFlapPortAction    `json:"macFlapActions,omitempty"`
+	Vlans            map[opennsl.Vlan]VlanConfig     `json:"vlans,omitempty"`
+	Pvid             map[string]opennsl.Vlan         `json:"pvid,omitempty"`
+	KeepVlanTag      map[string]bool                 `json:"keepVlanTag,omitempty"`
+	SubIfaces        map[string]SubIfaceConfig       `json:"subIfaces,omitempty"`
+	QinqPorts        map[string]QinqPortConfig       `json:"qinqPorts,omitempty"`
+	VlanTranslations map[string]VlanTranslation      `json:"vlanTranslations,omitempty"`
+	IsolationGroups  map[string]IsolationGroup       `json:"isolationGroups,omitempty"`
+	Ports            map[string]PortSettings         `json:"ports,omitempty"`
+	StormControl     map[string]StormControlConfig   `json:"stormControl,omitempty"`
+	MirrorSessions   map[string]MirrorSession        `json:"mirrorSessions,omitempty"`
+}
+
+func newConfig() Config {
+	return Config{
+		BpduProtection:   make(map[string]BpduProtectionConfig),
+		LearningPolicy:   make(map[string]LearningPolicy),
+		StaticFdb:        make(map[string]StaticFdbEntry),
+		PortSecurity:     make(map[string]PortSecurityConfig),
+		MacFlapActions:   make(map[string]MacFlapPortAction),
+		Vlans:            make(map[opennsl.Vlan]VlanConfig),
+		Pvid:             make(map[string]opennsl.Vlan),
+		KeepVlanTag:      make(map[string]bool),
+		SubIfaces:        make(map[string]SubIfaceConfig),
+		QinqPorts:        make(map[string]QinqPortConfig),
+		VlanTranslations: make(map[string]VlanTranslation),
+		IsolationGroups:  make(map[string]IsolationGroup),
+		Ports:            make(map[string]PortSettings),
+		StormControl:     make(map[string]StormControlConfig),
+		MirrorSessions:   make(map[string]MirrorSession),
+	}
+}
+
+type configStore struct {
+	mtx  sync.Mutex
+	path string
+	cfg  Config
+}
+
+func newConfigStore(path string) *configStore {
+	return &configStore{
+		path: path,
+		cfg:  newConfig(),
+	}
+}
+
+// load reads settings from the config file. Missing file means there is nothing to restore.
+func (store *configStore) load() error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	data, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		log.Infof("Config file %s does not exist, starting with defaults", store.path)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	cfg := newConfig()
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+
+	store.cfg = cfg
+	return nil
+}
+
+func (store *configStore) save() error {
+	data, err := json.MarshalIndent(&store.cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(store.path), 0755); err != nil {
+		return err
+	}
+
+	// Write to the temporary file first, so the config is never left half written.
+	tmpPath := store.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, store.path)
+}
+
+// update modifies settings and writes them to the config file.
+func (store *configStore) update(modify func(cfg *Config)) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	modify(&store.cfg)
+	if err := store.save(); err != nil {
+		log.Errorf("Failed to save config file %s: %s", store.path, err)
+		return err
+	}
+
+	return nil
+}
+
+// view gives read access to settings.
+func (store *configStore) view(read func(cfg *Config)) {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	read(&store.cfg)
+}
+
+// RestoreConfig applies settings preserved in the config file. It has to be called
+// once all L2 ports are created. Settings which cannot be restored, e.g. of ports which no
+// longer exist, are logged and skipped, so the switch still starts.
+func (sw *Switch) RestoreConfig() {
+	if err := sw.ports.restore(); err != nil {
+		log.Errorf("Failed to restore port settings: %s", err)
+	}
+
+	if err := sw.vlans.restore(); err != nil {
+		log.Errorf("Failed to restore VLANs: %s", err)
+	}
+
+	if err := sw.isolation.restore(); err != nil {
+		log.Errorf("Failed to restore isolation groups: %s", err)
+	}
+
+	if err := sw.restoreSubIfaces(); err != nil {
+		log.Errorf("Failed to restore VLAN sub-interfaces: %s", err)
+	}
+
+	if err := sw.bpduProtection.restore(); err != nil {
+		log.Errorf("Failed to restore BPDU protection settings: %s", err)
+	}
+
+	if err := sw.mirror.restore(); err != nil {
+		log.Errorf("Failed to restore mirror sessions: %s", err)
+	}
+
+	if err := sw.stormControl.restore(); err != nil {
+		log.Errorf("Failed to restore storm control settings: %s", err)
+	}
+
+	// Entries pointing at LAGs are installed once LAGs are created
+	if err := sw.fdb.Reinstall(""); err != nil {
+		log.Warnf("Not all static FDB entries have been restored: %s", err)
+	}
+
+	if err := sw.portSecurity.restore(); err != nil {
+		log.Errorf("Failed to restore port security settings: %s", err)
+	}
+
+	sw.macFlapDetector.start()
+	sw.stormControl.start()
+	sw.stpMtx.Lock()
+	defer sw.stpMtx.Unlock()
+	if err := sw.restoreLearningPolicies(); err != nil {
+		log.Errorf("Failed to restore MAC learning policies: %s", err)
+	}
+}
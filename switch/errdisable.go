@@ -0,0 +1,117 @@
+package bcm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrDisableEvent informs that port has been shut down because of a violation or brought back.
+type ErrDisableEvent struct {
+	PortName    string
+	Reason      string
+	ErrDisabled bool
+	Time        time.Time
+}
+
+type errDisabledPort struct {
+	reason   string
+	since    time.Time
+	recovery *time.Timer
+	// Tells apart successive err-disables of the same port, so a late recovery timer does not
+	// recover the port disabled again in the meantime
+	generation uint64
+}
+
+// ErrDisablePort shuts down port because of given reason. Port is enabled again after autoRecovery
+// period elapses, unless it is zero.
+func (sw *Switch) ErrDisablePort(portName string, reason string, autoRecovery time.Duration) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	sw.errDisableMtx.Lock()
+	defer sw.errDisableMtx.Unlock()
+
+	if _, exists := sw.errDisabled[portName]; exists {
+		return nil
+	}
+
+	if err := sdkCall("PortEnableSet", opennsl.PortEnableSet(sw.asic.unit, port, opennsl.FALSE)); err != nil {
+		log.Errorf("Failed to error-disable port %s (%d): %s", portName, port, err)
+		return err
+	}
+
+	sw.errDisableGen++
+	errDisabled := &errDisabledPort{reason: reason, since: time.Now(), generation: sw.errDisableGen}
+	if autoRecovery > 0 {
+		generation := errDisabled.generation
+		errDisabled.recovery = time.AfterFunc(autoRecovery, func() {
+			if err := sw.recoverErrDisabledPort(portName, generation); err != nil {
+				log.Errorf("Failed to recover error-disabled port %s: %s", portName, err)
+			}
+		})
+	}
+
+	sw.errDisabled[portName] = errDisabled
+	log.Warnf("Port %s has been error-disabled: %s", portName, reason)
+	sw.errDisableEvents.publish(&ErrDisableEvent{PortName: portName, Reason: reason, ErrDisabled: true, Time: errDisabled.since})
+	return nil
+}
+
+// RecoverErrDisabledPort enables port which has been error-disabled.
+func (sw *Switch) RecoverErrDisabledPort(portName string) error {
+	return sw.recoverErrDisabledPort(portName, 0)
+}
+
+// recoverErrDisabledPort enables error-disabled port. Non-zero generation is given by auto-recovery,
+// which does nothing if the port has been recovered or error-disabled again since.
+func (sw *Switch) recoverErrDisabledPort(portName string, generation uint64) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	sw.errDisableMtx.Lock()
+	defer sw.errDisableMtx.Unlock()
+
+	errDisabled, exists := sw.errDisabled[portName]
+	if generation != 0 {
+		if !exists || errDisabled.generation != generation {
+			return nil
+		}
+
+		log.Infof("Auto-recovery of error-disabled port %s", portName)
+	} else if !exists {
+		return fmt.Errorf("Port %s is not error-disabled", portName)
+	}
+
+	// Port shut down by user stays down
+	if !sw.portAdminDown(portName) {
+		if err := sdkCall("PortEnableSet", opennsl.PortEnableSet(sw.asic.unit, port, opennsl.TRUE)); err != nil {
+			log.Errorf("Failed to enable port %s (%d): %s", portName, port, err)
+			return err
+		}
+	}
+
+	if errDisabled.recovery != nil {
+		errDisabled.recovery.Stop()
+	}
+
+	delete(sw.errDisabled, portName)
+	log.Infof("Port %s has been recovered from error-disabled state (%s)", portName, errDisabled.reason)
+	sw.errDisableEvents.publish(&ErrDisableEvent{PortName: portName, Reason: errDisabled.reason, ErrDisabled: false, Time: time.Now()})
+	return nil
+}
+
+// IsErrDisabled tells if port is error-disabled.
+func (sw *Switch) IsErrDisabled(portName string) bool {
+	sw.errDisableMtx.Lock()
+	defer sw.errDisableMtx.Unlock()
+
+	_, exists := sw.errDisabled[portName]
+	return exists
+}
@@ -0,0 +1,58 @@
+package bcm
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	EVENT_QUEUE_SIZE = 256
+)
+
+// eventHub delivers events to all of its subscribers. Slow subscribers lose events
+// instead of blocking the publisher.
+type eventHub struct {
+	mtx  sync.Mutex
+	name string
+	subs map[chan interface{}]struct{}
+}
+
+func newEventHub(name string) *eventHub {
+	return &eventHub{
+		name: name,
+		subs: make(map[chan interface{}]struct{}),
+	}
+}
+
+func (hub *eventHub) subscribe() chan interface{} {
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+
+	ch := make(chan interface{}, EVENT_QUEUE_SIZE)
+	hub.subs[ch] = struct{}{}
+	return ch
+}
+
+func (hub *eventHub) unsubscribe(ch chan interface{}) {
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+
+	if _, exists := hub.subs[ch]; exists {
+		delete(hub.subs, ch)
+		close(ch)
+	}
+}
+
+func (hub *eventHub) publish(event interface{}) {
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+
+	for ch := range hub.subs {
+		select {
+		case ch <- event:
+		default:
+			log.Warnf("Subscriber of %s events is too slow, dropping event", hub.name)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchFdb/gRPCServices"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	fdbMgmtPort = ":50054"
+)
+
+// StaticFdbEntry represents MAC address entry kept by the daemon.
+type StaticFdbEntry struct {
+	MAC       string `json:"mac"`
+	Vlan      uint16 `json:"vlan"`
+	Ifname    string `json:"ifname,omitempty"`
+	Blackhole bool   `json:"blackhole,omitempty"`
+	// Entry learned on port with sticky port security
+	Sticky bool `json:"sticky,omitempty"`
+}
+
+func (entry StaticFdbEntry) key() string {
+	return fdbEntryKey(entry.MAC, entry.Vlan)
+}
+
+func fdbEntryKey(mac string, vlan uint16) string {
+	return fmt.Sprintf("%s/%d", mac, vlan)
+}
+
+// FdbEntry represents L2 address entry read from hardware.
+type FdbEntry struct {
+	MAC       net.HardwareAddr
+	Vlan      opennsl.Vlan
+	Ifname    string
+	Static    bool
+	Blackhole bool
+}
+
+type fdbManager struct {
+	sw  *Switch
+	mtx sync.Mutex
+}
+
+func newFdbManager(sw *Switch) *fdbManager {
+	return &fdbManager{sw: sw}
+}
+
+func parseFdbAddr(mac string, vlan uint32) (net.HardwareAddr, opennsl.Vlan, error) {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, opennsl.VLAN_ID_NONE, fmt.Errorf("Invalid MAC address %s: %s", mac, err)
+	}
+
+	vid := opennsl.Vlan(vlan)
+	if !vid.Valid() {
+		return nil, opennsl.VLAN_ID_NONE, fmt.Errorf("Invalid VLAN ID %d", vlan)
+	}
+
+	return hwAddr, vid, nil
+}
+
+// install programs static entry into hardware.
+func (fdb *fdbManager) install(entry StaticFdbEntry) error {
+	hwAddr, vid, err := parseFdbAddr(entry.MAC, uint32(entry.Vlan))
+	if err != nil {
+		return err
+	}
+
+	l2Addr := opennsl.NewL2Addr(hwAddr, vid)
+	flags := []opennsl.L2Flag{opennsl.L2_STATIC}
+	if entry.Blackhole {
+		flags = append(flags, opennsl.L2_DISCARD_DST)
+	}
+
+	if len(entry.Ifname) > 0 {
+		iface, err := fdb.sw.ResolveL2Iface(entry.Ifname)
+		if err != nil {
+			return err
+		}
+
+		if iface.Kind == IFACE_KIND_LAG {
+			flags = append(flags, opennsl.L2_TRUNK_MEMBER)
+			l2Addr.SetTGID(iface.Trunk)
+		} else {
+			l2Addr.SetPort(iface.Port)
+		}
+	} else if !entry.Blackhole {
+		return fmt.Errorf("Static entry %s has to point at port or LAG", entry.key())
+	}
+
+	l2Addr.SetFlags(opennsl.NewL2Flags(flags...))
+	if err := l2Addr.Add(fdb.sw.asic.unit); err != nil {
+		log.Errorf("Failed to add static entry %s: %s", entry.key(), err)
+		return err
+	}
+
+	return nil
+}
+
+// AddStaticEntry installs static entry and keeps it, so it survives flushes and restarts.
+func (fdb *fdbManager) AddStaticEntry(entry StaticFdbEntry) error {
+	fdb.mtx.Lock()
+	defer fdb.mtx.Unlock()
+
+	if err := fdb.install(entry); err != nil {
+		return err
+	}
+
+	return fdb.sw.cfg.update(func(cfg *Config) {
+		cfg.StaticFdb[entry.key()] = entry
+	})
+}
+
+// installKernelEntry programs static entry configured on kernel bridge. It is not kept in
+// config, as kernel bridge is the owner of the entry.
+func (fdb *fdbManager) installKernelEntry(entry StaticFdbEntry) error {
+	fdb.mtx.Lock()
+	defer fdb.mtx.Unlock()
+
+	return fdb.install(entry)
+}
+
+// deleteKernelEntry removes static entry removed from kernel bridge, unless it is also
+// configured as static entry of the switch.
+func (fdb *fdbManager) deleteKernelEntry(entry StaticFdbEntry) error {
+	fdb.mtx.Lock()
+	defer fdb.mtx.Unlock()
+
+	configured := false
+	fdb.sw.cfg.view(func(cfg *Config) {
+		_, configured = cfg.StaticFdb[entry.key()]
+	})
+
+	if configured {
+		return nil
+	}
+
+	hwAddr, vid, err := parseFdbAddr(entry.MAC, uint32(entry.Vlan))
+	if err != nil {
+		return err
+	}
+
+	return opennsl.L2AddrDelete(fdb.sw.asic.unit, hwAddr, vid)
+}
+
+// DeleteEntry removes L2 address from hardware. Static entry is forgotten as well.
+func (fdb *fdbManager) DeleteEntry(hwAddr net.HardwareAddr, vid opennsl.Vlan) error {
+	fdb.mtx.Lock()
+	defer fdb.mtx.Unlock()
+
+	if err := opennsl.L2AddrDelete(fdb.sw.asic.unit, hwAddr, vid); err != nil {
+		log.Errorf("Failed to delete L2 address %s in VLAN %d: %s", hwAddr, vid, err)
+		return err
+	}
+
+	return fdb.sw.cfg.update(func(cfg *Config) {
+		delete(cfg.StaticFdb, fdbEntryKey(hwAddr.String(), uint16(vid)))
+	})
+}
+
+// Reinstall programs again static entries pointing at given interface or all of them if name is empty.
+func (fdb *fdbManager) Reinstall(ifname string) error {
+	fdb.mtx.Lock()
+	defer fdb.mtx.Unlock()
+
+	var entries []StaticFdbEntry
+	fdb.sw.cfg.view(func(cfg *Config) {
+		for _, entry := range cfg.StaticFdb {
+			if len(ifname) == 0 || entry.Ifname == ifname {
+				entries = append(entries, entry)
+			}
+		}
+	})
+
+	var lastErr error
+	for _, entry := range entries {
+		log.Debugf("Installing static entry %s on interface %s", entry.key(), entry.Ifname)
+		if err := fdb.install(entry); err != nil {
+			// Keep going, e.g. LAG may not have been created yet
+			log.Warnf("Failed to install static entry %s: %s", entry.key(), err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// Entries reads L2 addresses from hardware.
+func (fdb *fdbManager) Entries() ([]*FdbEntry, error) {
+	var entries []*FdbEntry
+	err := opennsl.L2Traverse(fdb.sw.asic.unit, func(unit int, l2Addr *opennsl.L2Addr) error {
+		flags := l2Addr.Flags()
+		entry := &FdbEntry{
+			MAC:       l2Addr.MAC(),
+			Vlan:      l2Addr.VID(),
+			Static:    flags.Has(opennsl.L2_STATIC),
+			Blackhole: flags.Has(opennsl.L2_DISCARD_DST),
+		}
+
+		if flags.Has(opennsl.L2_TRUNK_MEMBER) {
+			entry.Ifname, _ = fdb.sw.lagNameOf(l2Addr.TGID())
+		} else {
+			entry.Ifname, _ = portNameOf(l2Addr.Port())
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+
+	if err != nil {
+		log.Errorf("Failed to read L2 addresses: %s", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+type fdbRequestMgmt struct {
+	pb.UnimplementedFdbManagementServer
+	sw *Switch
+}
+
+func (fdbMgmt *fdbRequestMgmt) ListEntries(ctx context.Context, filter *pb.FdbFilter) (*pb.FdbEntries, error) {
+	var ifname string
+	if len(filter.GetIfname()) > 0 {
+		iface, err := fdbMgmt.sw.ResolveL2Iface(filter.GetIfname())
+		if err != nil {
+			log.Errorf("%s", err)
+			return nil, err
+		}
+
+		ifname = iface.Name
+	}
+
+	var hwAddr net.HardwareAddr
+	if len(filter.GetMac()) > 0 {
+		var err error
+		if hwAddr, err = net.ParseMAC(filter.GetMac()); err != nil {
+			log.Errorf("Invalid MAC address %s: %s", filter.GetMac(), err)
+			return nil, err
+		}
+	}
+
+	entries, err := fdbMgmt.sw.fdb.Entries()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read FDB: %s", err)
+	}
+
+	result := &pb.FdbEntries{}
+	for _, entry := range entries {
+		if len(ifname) > 0 && entry.Ifname != ifname {
+			continue
+		}
+
+		if filter.GetVlan() != 0 && uint32(entry.Vlan) != filter.GetVlan() {
+			continue
+		}
+
+		if hwAddr != nil && !bytes.Equal(hwAddr, entry.MAC) {
+			continue
+		}
+
+		result.Entries = append(result.Entries, &pb.FdbEntry{
+			Mac:       entry.MAC.String(),
+			Vlan:      uint32(entry.Vlan),
+			Ifname:    entry.Ifname,
+			Static:    entry.Static,
+			Blackhole: entry.Blackhole,
+		})
+	}
+
+	return result, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) AddStaticEntry(ctx context.Context, req *pb.FdbEntry) (*pb.FdbResult, error) {
+	log.Infof("AddStaticEntry: MAC %s, VLAN %d, ifname %s, blackhole %t", req.GetMac(), req.GetVlan(), req.GetIfname(), req.GetBlackhole())
+	hwAddr, vid, err := parseFdbAddr(req.GetMac(), req.GetVlan())
+	if err != nil {
+		log.Errorf("%s", err)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	entry := StaticFdbEntry{
+		MAC:       hwAddr.String(),
+		Vlan:      uint16(vid),
+		Ifname:    req.GetIfname(),
+		Blackhole: req.GetBlackhole(),
+	}
+
+	if err := fdbMgmt.sw.fdb.AddStaticEntry(entry); err != nil {
+		log.Errorf("Failed to add static entry %s: %s", entry.key(), err)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, fmt.Errorf("Failed to add static entry %s: %s", entry.key(), err)
+	}
+
+	return &pb.FdbResult{Result: pb.FdbResult_SUCCESS}, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) DeleteEntry(ctx context.Context, req *pb.FdbEntry) (*pb.FdbResult, error) {
+	log.Infof("DeleteEntry: MAC %s, VLAN %d", req.GetMac(), req.GetVlan())
+	hwAddr, vid, err := parseFdbAddr(req.GetMac(), req.GetVlan())
+	if err != nil {
+		log.Errorf("%s", err)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	if err := fdbMgmt.sw.fdb.DeleteEntry(hwAddr, vid); err != nil {
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, fmt.Errorf("Failed to delete entry %s: %s", fdbEntryKey(hwAddr.String(), uint16(vid)), err)
+	}
+
+	return &pb.FdbResult{Result: pb.FdbResult_SUCCESS}, nil
+}
+
+var fdbEventTypes = map[L2AddrEventType]pb.FdbEvent_Type{
+	L2_ADDR_LEARN: pb.FdbEvent_LEARN,
+	L2_ADDR_AGE:   pb.FdbEvent_AGE,
+	L2_ADDR_MOVE:  pb.FdbEvent_MOVE,
+}
+
+// WatchEvents streams learned, aged out and moved MAC addresses matching the filter.
+func (fdbMgmt *fdbRequestMgmt) WatchEvents(filter *pb.FdbFilter, stream pb.FdbManagement_WatchEventsServer) error {
+	var ifname string
+	if len(filter.GetIfname()) > 0 {
+		iface, err := fdbMgmt.sw.ResolveL2Iface(filter.GetIfname())
+		if err != nil {
+			log.Errorf("%s", err)
+			return err
+		}
+
+		ifname = iface.Name
+	}
+
+	var hwAddr net.HardwareAddr
+	if len(filter.GetMac()) > 0 {
+		var err error
+		if hwAddr, err = net.ParseMAC(filter.GetMac()); err != nil {
+			log.Errorf("Invalid MAC address %s: %s", filter.GetMac(), err)
+			return err
+		}
+	}
+
+	events := fdbMgmt.sw.l2Events.subscribe()
+	defer fdbMgmt.sw.l2Events.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev := <-events:
+			event := ev.(*L2AddrEvent)
+			if len(ifname) > 0 && event.Ifname != ifname && event.PrevIfname != ifname {
+				continue
+			}
+
+			if filter.GetVlan() != 0 && uint32(event.Vlan) != filter.GetVlan() {
+				continue
+			}
+
+			if hwAddr != nil && !bytes.Equal(hwAddr, event.MAC) {
+				continue
+			}
+
+			if err := stream.Send(&pb.FdbEvent{
+				Type:       fdbEventTypes[event.Type],
+				Mac:        event.MAC.String(),
+				Vlan:       uint32(event.Vlan),
+				Ifname:     event.Ifname,
+				PrevIfname: event.PrevIfname,
+				Timestamp:  event.Time.Unix(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func HandleFdbRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", fdbMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterFdbManagementServer(s, &fdbRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -12,6 +12,11 @@ import (
 func (sw *Switch) Init() error {
 	log.SetLevel(log.DebugLevel)
 
+	if err := sw.cfg.load(); err != nil {
+		log.Errorf("Failed to load config file: %s", err)
+		return err
+	}
+
 	if err := sal.DriverInit(); err != nil {
 		log.Errorf("Failed to initialize BCM network switch driver: %s", err)
 		return err
@@ -22,6 +27,11 @@ func (sw *Switch) Init() error {
 		return err
 	}
 
+	if err := opennsl.MirrorInit(sw.asic.unit); err != nil {
+		log.Errorf("Failed to initialize the mirror module: %s", err)
+		return err
+	}
+
 	if err := util.PortDefaultConfig(sw.asic.unit); err != nil {
 		log.Errorf("Failed to apply default configuration for ports: %s", err)
 		return err
@@ -0,0 +1,311 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchVlan/gRPCServices"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Module ID of local device
+	localModID          = -1
+	isolationFloodBlock = opennsl.PORT_FLOOD_BLOCK_BCAST | opennsl.PORT_FLOOD_BLOCK_UNKNOWN_UCAST |
+		opennsl.PORT_FLOOD_BLOCK_UNKNOWN_MCAST
+)
+
+// IsolationRole represents private VLAN role of port in isolation group.
+type IsolationRole int
+
+const (
+	// Reaches promiscuous ports only
+	ISOLATION_ROLE_ISOLATED IsolationRole = iota
+	// Reaches promiscuous ports and ports of the same community
+	ISOLATION_ROLE_COMMUNITY
+	// Uplink, which reaches all ports of the group
+	ISOLATION_ROLE_PROMISCUOUS
+)
+
+var isolationRoleNames = map[IsolationRole]string{
+	ISOLATION_ROLE_ISOLATED:    "isolated",
+	ISOLATION_ROLE_COMMUNITY:   "community",
+	ISOLATION_ROLE_PROMISCUOUS: "promiscuous",
+}
+
+func (role IsolationRole) String() string {
+	return isolationRoleNames[role]
+}
+
+// IsolationPort represents role of port in isolation group.
+type IsolationPort struct {
+	Role      IsolationRole `json:"role"`
+	Community string        `json:"community,omitempty"`
+}
+
+// IsolationGroup represents ports whose traffic between each other is restricted according
+// to their roles. Traffic to ports outside of the group is not affected.
+type IsolationGroup struct {
+	Ports map[string]IsolationPort `json:"ports"`
+}
+
+// reaches tells if traffic received on port of given role may be sent to the other port.
+func (src IsolationPort) reaches(dst IsolationPort) bool {
+	if src.Role == ISOLATION_ROLE_PROMISCUOUS || dst.Role == ISOLATION_ROLE_PROMISCUOUS {
+		return true
+	}
+
+	return src.Role == ISOLATION_ROLE_COMMUNITY && dst.Role == ISOLATION_ROLE_COMMUNITY && src.Community == dst.Community
+}
+
+type portPair struct {
+	ingress opennsl.Port
+	egress  opennsl.Port
+}
+
+// portIsolation programs isolation groups into egress masks and flood blocks of ports.
+type portIsolation struct {
+	sw           *Switch
+	mtx          sync.Mutex
+	floodBlocked map[portPair]struct{}
+}
+
+func newPortIsolation(sw *Switch) *portIsolation {
+	return &portIsolation{
+		sw:           sw,
+		floodBlocked: make(map[portPair]struct{}),
+	}
+}
+
+func validateIsolationGroup(name string, group IsolationGroup, groups map[string]IsolationGroup) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return fmt.Errorf("Name of isolation group cannot be empty")
+	}
+
+	for portName, isolationPort := range group.Ports {
+		if _, err := portByName(portName); err != nil {
+			return err
+		}
+
+		if _, exists := isolationRoleNames[isolationPort.Role]; !exists {
+			return fmt.Errorf("Unknown role %d of port %s", isolationPort.Role, portName)
+		}
+
+		if isolationPort.Role == ISOLATION_ROLE_COMMUNITY && len(isolationPort.Community) == 0 {
+			return fmt.Errorf("Community of port %s is not set", portName)
+		}
+
+		for otherName, otherGroup := range groups {
+			if _, exists := otherGroup.Ports[portName]; exists && otherName != name {
+				return fmt.Errorf("Port %s is already member of isolation group %s", portName, otherName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockedPairs computes pairs of ports, between which traffic is not allowed.
+func blockedPairs(groups map[string]IsolationGroup) (map[portPair]struct{}, error) {
+	blocked := make(map[portPair]struct{})
+	for _, group := range groups {
+		for srcName, src := range group.Ports {
+			srcPort, err := portByName(srcName)
+			if err != nil {
+				return nil, err
+			}
+
+			for dstName, dst := range group.Ports {
+				if srcName == dstName || src.reaches(dst) {
+					continue
+				}
+
+				dstPort, err := portByName(dstName)
+				if err != nil {
+					return nil, err
+				}
+
+				blocked[portPair{ingress: srcPort, egress: dstPort}] = struct{}{}
+			}
+		}
+	}
+
+	return blocked, nil
+}
+
+// apply recomputes egress masks of all front panel ports and updates flood blocks which changed.
+func (isolation *portIsolation) apply(groups map[string]IsolationGroup) error {
+	blocked, err := blockedPairs(groups)
+	if err != nil {
+		return err
+	}
+
+	pcfg, err := opennsl.PortConfigGet(isolation.sw.asic.unit)
+	if err != nil {
+		return err
+	}
+
+	for _, portNameMap := range PortNames {
+		// Egress mask contains all ports, including CPU port, except blocked ones
+		egressBmp, err := pcfg.PBmp(opennsl.PORT_CONFIG_ALL)
+		if err != nil {
+			return err
+		}
+
+		for pair := range blocked {
+			if pair.ingress == portNameMap.Port {
+				egressBmp.Remove(pair.egress)
+			}
+		}
+
+		if err := opennsl.PortEgressSet(isolation.sw.asic.unit, portNameMap.Port, localModID, egressBmp); err != nil {
+			return fmt.Errorf("Failed to set egress mask of port %s: %s", portNameMap.PortName, err)
+		}
+	}
+
+	for pair := range isolation.floodBlocked {
+		if _, exists := blocked[pair]; exists {
+			continue
+		}
+
+		if err := opennsl.PortFloodBlockSet(isolation.sw.asic.unit, pair.ingress, pair.egress, 0); err != nil {
+			return fmt.Errorf("Failed to remove flood block from port %d to %d: %s", pair.ingress, pair.egress, err)
+		}
+
+		delete(isolation.floodBlocked, pair)
+	}
+
+	for pair := range blocked {
+		if _, exists := isolation.floodBlocked[pair]; exists {
+			continue
+		}
+
+		if err := opennsl.PortFloodBlockSet(isolation.sw.asic.unit, pair.ingress, pair.egress, isolationFloodBlock); err != nil {
+			return fmt.Errorf("Failed to set flood block from port %d to %d: %s", pair.ingress, pair.egress, err)
+		}
+
+		isolation.floodBlocked[pair] = struct{}{}
+	}
+
+	return nil
+}
+
+func (isolation *portIsolation) groups() map[string]IsolationGroup {
+	groups := make(map[string]IsolationGroup)
+	isolation.sw.cfg.view(func(c *Config) {
+		for name, group := range c.IsolationGroups {
+			groups[name] = group
+		}
+	})
+
+	return groups
+}
+
+// SetGroup creates isolation group or replaces its ports.
+func (isolation *portIsolation) SetGroup(name string, group IsolationGroup) error {
+	isolation.mtx.Lock()
+	defer isolation.mtx.Unlock()
+
+	groups := isolation.groups()
+	if err := validateIsolationGroup(name, group, groups); err != nil {
+		return err
+	}
+
+	groups[name] = group
+	if err := isolation.apply(groups); err != nil {
+		return err
+	}
+
+	return isolation.sw.cfg.update(func(c *Config) {
+		c.IsolationGroups[name] = group
+	})
+}
+
+// DeleteGroup removes isolation group, so its ports can reach each other again.
+func (isolation *portIsolation) DeleteGroup(name string) error {
+	isolation.mtx.Lock()
+	defer isolation.mtx.Unlock()
+
+	groups := isolation.groups()
+	if _, exists := groups[name]; !exists {
+		return fmt.Errorf("Isolation group %s does not exist", name)
+	}
+
+	delete(groups, name)
+	if err := isolation.apply(groups); err != nil {
+		return err
+	}
+
+	return isolation.sw.cfg.update(func(c *Config) {
+		delete(c.IsolationGroups, name)
+	})
+}
+
+func (isolation *portIsolation) restore() error {
+	isolation.mtx.Lock()
+	defer isolation.mtx.Unlock()
+
+	groups := isolation.groups()
+	if len(groups) == 0 {
+		return nil
+	}
+
+	return isolation.apply(groups)
+}
+
+func (vlanMgmt *vlanRequestMgmt) SetIsolationGroup(ctx context.Context, req *pb.VlanIsolationGroup) (*pb.VlanResult, error) {
+	log.Infof("SetIsolationGroup: group %s, %d ports", req.GetName(), len(req.GetPorts()))
+	group := IsolationGroup{Ports: make(map[string]IsolationPort)}
+	for _, port := range req.GetPorts() {
+		group.Ports[port.GetIfname()] = IsolationPort{
+			Role:      IsolationRole(port.GetRole()),
+			Community: port.GetCommunity(),
+		}
+	}
+
+	if err := vlanMgmt.sw.isolation.SetGroup(req.GetName(), group); err != nil {
+		log.Errorf("Failed to set isolation group %s: %s", req.GetName(), err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) DeleteIsolationGroup(ctx context.Context, req *pb.VlanIsolationGroupName) (*pb.VlanResult, error) {
+	log.Infof("DeleteIsolationGroup: group %s", req.GetName())
+	if err := vlanMgmt.sw.isolation.DeleteGroup(req.GetName()); err != nil {
+		log.Errorf("Failed to delete isolation group %s: %s", req.GetName(), err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) ListIsolationGroups(ctx context.Context, req *pb.VlanEmpty) (*pb.VlanIsolationGroupList, error) {
+	result := &pb.VlanIsolationGroupList{}
+	for name, group := range vlanMgmt.sw.isolation.groups() {
+		pbGroup := &pb.VlanIsolationGroup{Name: name}
+		for portName, isolationPort := range group.Ports {
+			pbGroup.Ports = append(pbGroup.Ports, &pb.VlanIsolationPort{
+				Ifname:    portName,
+				Role:      pb.VlanIsolationPort_Role(isolationPort.Role),
+				Community: isolationPort.Community,
+			})
+		}
+
+		sort.Slice(pbGroup.Ports, func(i, j int) bool {
+			return pbGroup.Ports[i].Ifname < pbGroup.Ports[j].Ifname
+		})
+		result.Groups = append(result.Groups, pbGroup)
+	}
+
+	sort.Slice(result.Groups, func(i, j int) bool {
+		return result.Groups[i].Name < result.Groups[j].Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package bcm
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// kernelFdbSync mirrors L2 addresses learned by hardware into the FDB of port
+// netdevs, or of the bridge they are enslaved to, so kernel sees what hardware forwards.
+type kernelFdbSync struct {
+	sw *Switch
+}
+
+func newKernelFdbSync(sw *Switch) *kernelFdbSync {
+	return &kernelFdbSync{sw: sw}
+}
+
+func (kfdb *kernelFdbSync) neigh(event *L2AddrEvent, ifname string) (*netlink.Neigh, error) {
+	link, err := netlink.LinkByName(ifname)
+	if err != nil {
+		return nil, fmt.Errorf("Netdev %s not found: %s", ifname, err)
+	}
+
+	// Entry goes to the bridge FDB if netdev is enslaved, to its own FDB otherwise
+	flags := unix.NTF_EXT_LEARNED | unix.NTF_OFFLOADED
+	if link.Attrs().MasterIndex > 0 {
+		flags |= netlink.NTF_MASTER
+	} else {
+		flags |= netlink.NTF_SELF
+	}
+
+	return &netlink.Neigh{
+		LinkIndex:    link.Attrs().Index,
+		Family:       unix.AF_BRIDGE,
+		State:        netlink.NUD_REACHABLE,
+		Flags:        flags,
+		HardwareAddr: event.MAC,
+		Vlan:         int(event.Vlan),
+	}, nil
+}
+
+func (kfdb *kernelFdbSync) add(event *L2AddrEvent, ifname string) error {
+	neigh, err := kfdb.neigh(event, ifname)
+	if err != nil {
+		return err
+	}
+
+	return netlink.NeighSet(neigh)
+}
+
+func (kfdb *kernelFdbSync) del(event *L2AddrEvent, ifname string) error {
+	neigh, err := kfdb.neigh(event, ifname)
+	if err != nil {
+		return err
+	}
+
+	return netlink.NeighDel(neigh)
+}
+
+func (kfdb *kernelFdbSync) handleL2AddrEvent(event *L2AddrEvent) {
+	if event.Static || len(event.Ifname) == 0 {
+		return
+	}
+
+	var err error
+	switch event.Type {
+	case L2_ADDR_LEARN:
+		err = kfdb.add(event, event.Ifname)
+	case L2_ADDR_AGE:
+		err = kfdb.del(event, event.Ifname)
+	case L2_ADDR_MOVE:
+		if len(event.PrevIfname) > 0 {
+			if err := kfdb.del(event, event.PrevIfname); err != nil {
+				log.Debugf("Failed to remove moved address %s from kernel FDB of %s: %s", event.MAC, event.PrevIfname, err)
+			}
+		}
+
+		err = kfdb.add(event, event.Ifname)
+	}
+
+	if err != nil {
+		log.Warnf("Failed to sync %s of address %s in VLAN %d on %s into kernel FDB: %s",
+			event.Type, event.MAC, event.Vlan, event.Ifname, err)
+	}
+}
+
+// run copies addresses already learned by hardware and then follows L2 address events.
+func (kfdb *kernelFdbSync) run(events chan interface{}) {
+	entries, err := kfdb.sw.fdb.Entries()
+	if err != nil {
+		log.Errorf("Failed to read FDB for initial kernel FDB sync: %s", err)
+	}
+
+	for _, entry := range entries {
+		kfdb.handleL2AddrEvent(&L2AddrEvent{
+			Type:   L2_ADDR_LEARN,
+			MAC:    entry.MAC,
+			Vlan:   entry.Vlan,
+			Ifname: entry.Ifname,
+			Static: entry.Static,
+		})
+	}
+
+	for ev := range events {
+		kfdb.handleL2AddrEvent(ev.(*L2AddrEvent))
+	}
+}
+
+// StartKernelFdbSync starts mirroring hardware learned L2 addresses into kernel FDB.
+func (sw *Switch) StartKernelFdbSync() {
+	log.Infof("Starting sync of hardware learned addresses into kernel FDB")
+	events := sw.l2Events.subscribe()
+	go newKernelFdbSync(sw).run(events)
+}
@@ -0,0 +1,122 @@
+package bcm
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+// L2AddrEventType tells what happened to L2 address.
+type L2AddrEventType int
+
+const (
+	L2_ADDR_LEARN L2AddrEventType = iota
+	L2_ADDR_AGE
+	L2_ADDR_MOVE
+)
+
+var l2AddrEventTypeNames = map[L2AddrEventType]string{
+	L2_ADDR_LEARN: "learn",
+	L2_ADDR_AGE:   "age",
+	L2_ADDR_MOVE:  "move",
+}
+
+func (evType L2AddrEventType) String() string {
+	return l2AddrEventTypeNames[evType]
+}
+
+// L2AddrEvent informs about L2 address learned, aged out or moved by hardware.
+type L2AddrEvent struct {
+	Type       L2AddrEventType
+	MAC        net.HardwareAddr
+	Vlan       opennsl.Vlan
+	Ifname     string
+	PrevIfname string
+	Static     bool
+	Time       time.Time
+}
+
+// l2AddrWatcher converts SDK L2 address notifications into events.
+type l2AddrWatcher struct {
+	sw  *Switch
+	mtx sync.Mutex
+	// Interfaces which addresses being moved are deleted from
+	moving map[string]string
+}
+
+func newL2AddrWatcher(sw *Switch) *l2AddrWatcher {
+	return &l2AddrWatcher{
+		sw:     sw,
+		moving: make(map[string]string),
+	}
+}
+
+// ifnameOfL2Addr returns name of port or LAG which L2 address points at.
+func (sw *Switch) ifnameOfL2Addr(l2Addr *opennsl.L2Addr) string {
+	var ifname string
+	var err error
+	if l2Addr.Flags().Has(opennsl.L2_TRUNK_MEMBER) {
+		ifname, err = sw.lagNameOf(l2Addr.TGID())
+	} else {
+		ifname, err = portNameOf(l2Addr.Port())
+	}
+
+	if err != nil {
+		log.Debugf("L2 address %s is not bound to front panel interface: %s", l2Addr.MAC(), err)
+	}
+
+	return ifname
+}
+
+func (watcher *l2AddrWatcher) handleL2Addr(unit int, l2Addr *opennsl.L2Addr, oper opennsl.L2CallbackOper) {
+	flags := l2Addr.Flags()
+	event := &L2AddrEvent{
+		MAC:    l2Addr.MAC(),
+		Vlan:   l2Addr.VID(),
+		Ifname: watcher.sw.ifnameOfL2Addr(l2Addr),
+		Static: flags.Has(opennsl.L2_STATIC),
+		Time:   time.Now(),
+	}
+
+	key := fdbEntryKey(event.MAC.String(), uint16(event.Vlan))
+	moved := flags.Has(opennsl.L2_MOVE_PORT) || oper == opennsl.L2_CALLBACK_MOVE_EVENT
+	switch oper {
+	case opennsl.L2_CALLBACK_ADD, opennsl.L2_CALLBACK_LEARN_EVENT, opennsl.L2_CALLBACK_MOVE_EVENT:
+		event.Type = L2_ADDR_LEARN
+		if moved {
+			event.Type = L2_ADDR_MOVE
+			watcher.mtx.Lock()
+			event.PrevIfname = watcher.moving[key]
+			delete(watcher.moving, key)
+			watcher.mtx.Unlock()
+		}
+	case opennsl.L2_CALLBACK_DELETE, opennsl.L2_CALLBACK_AGE_EVENT:
+		if moved {
+			// Station move is reported as deletion from the old port followed by addition to the new one
+			watcher.mtx.Lock()
+			watcher.moving[key] = event.Ifname
+			watcher.mtx.Unlock()
+			return
+		}
+
+		event.Type = L2_ADDR_AGE
+	default:
+		return
+	}
+
+	log.Debugf("L2 address %s %s in VLAN %d on %s", event.MAC, event.Type, event.Vlan, event.Ifname)
+	watcher.sw.l2Events.publish(event)
+}
+
+// RegisterL2AddrCallback makes SDK notify the daemon about learned, aged and moved L2 addresses.
+func (sw *Switch) RegisterL2AddrCallback() error {
+	if err := opennsl.L2AddrRegister(sw.asic.unit, sw.l2AddrWatcher.handleL2Addr); err != nil {
+		log.Errorf("Failed to register L2 address callback: %s", err)
+		return err
+	}
+
+	return nil
+}
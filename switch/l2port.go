@@ -1,14 +1,55 @@
 package bcm
 
 import (
+	"fmt"
 	"net"
+	"sync"
 
 	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
 )
 
 type l2PortKnetFiltersType map[string]int
 
+const (
+	bpduKnetFilterDesc = "Catch BPDU"
+)
+
+// SubIfaceMode tells how frames of VLAN sub-interface are delivered to Linux.
+type SubIfaceMode int
+
+const (
+	// Frames are delivered untagged to dedicated KNET netdev named <port>.<vid>
+	SUBIF_MODE_KNET_NETDEV SubIfaceMode = iota
+	// Frames are delivered tagged to port netdev, where Linux VLAN device can be created
+	SUBIF_MODE_TAGGED_TO_PORT
+)
+
+var subIfaceModeNames = map[SubIfaceMode]string{
+	SUBIF_MODE_KNET_NETDEV:    "KNET netdev",
+	SUBIF_MODE_TAGGED_TO_PORT: "tagged to port netdev",
+}
+
+func (mode SubIfaceMode) String() string {
+	return subIfaceModeNames[mode]
+}
+
+type l2PortSubIface struct {
+	mode           SubIfaceMode
+	knetNetIfaceID int
+}
+
+func subIfaceName(portName string, vlan opennsl.Vlan) string {
+	return fmt.Sprintf("%s.%d", portName, vlan)
+}
+
+func subIfaceKnetFilterDesc(vlan opennsl.Vlan) string {
+	return fmt.Sprintf("VLAN %d sub-interface", vlan)
+}
+
 type L2Port struct {
+	// Guards KNET filters and netdevs changed after the port is created
+	mtx            sync.Mutex
 	asic           Asic
 	portName       string
 	port           opennsl.Port
@@ -16,6 +57,10 @@ type L2Port struct {
 	macAddr        net.HardwareAddr
 	knetNetIfaceID int
 	knetFilters    l2PortKnetFiltersType
+	knetFilterPrio int
+	bpduDest       opennsl.KnetDestType
+	stripTag       bool
+	subIfaces      map[opennsl.Vlan]*l2PortSubIface
 }
 
 func NewL2Port(portName string, port opennsl.Port, vlan opennsl.Vlan, macAddr net.HardwareAddr) *L2Port {
@@ -26,6 +71,9 @@ func NewL2Port(portName string, port opennsl.Port, vlan opennsl.Vlan, macAddr ne
 		vlan:        vlan,
 		macAddr:     macAddr,
 		knetFilters: make(l2PortKnetFiltersType),
+		bpduDest:    opennsl.KNET_DEST_T_NETIF,
+		stripTag:    true,
+		subIfaces:   make(map[opennsl.Vlan]*l2PortSubIface),
 	}
 }
 
@@ -44,19 +92,28 @@ func (l2Port *L2Port) setupKnetNetIface() error {
 	return nil
 }
 
-func (l2Port *L2Port) setupKnetFilter(rxReason opennsl.RxReason, prio int, desc string) error {
+func knetFilterFlags(stripTag bool) opennsl.KnetFilterFlags {
+	if stripTag {
+		return opennsl.NewKnetFilterFlags(opennsl.KNET_FILTER_F_STRIP_TAG)
+	}
+
+	return opennsl.NewKnetFilterFlags()
+}
+
+func (l2Port *L2Port) setupKnetFilter(rxReason opennsl.RxReason, prio int, desc string, destType opennsl.KnetDestType) error {
 	knetFilter := opennsl.NewKnetFilter()
 	knetFilter.SetDescription(desc)
 	knetFilter.SetType(opennsl.KNET_FILTER_T_RX_PKT)
-	knetFilter.SetFlags(opennsl.NewKnetFilterFlags(
-		opennsl.KNET_FILTER_F_STRIP_TAG,
-	))
+	knetFilter.SetFlags(knetFilterFlags(l2Port.stripTag))
 	knetFilter.SetMatchFlags(opennsl.NewKnetFilterMatchFlags(
 		opennsl.KNET_FILTER_M_REASON,
 		opennsl.KNET_FILTER_M_INGPORT,
 	))
-	knetFilter.SetDestType(opennsl.KNET_DEST_T_NETIF)
-	knetFilter.SetDestID(l2Port.knetNetIfaceID)
+	knetFilter.SetDestType(destType)
+	if destType == opennsl.KNET_DEST_T_NETIF {
+		knetFilter.SetDestID(l2Port.knetNetIfaceID)
+	}
+
 	knetFilter.SetPriority(prio)
 	knetFilter.SetRxReason(rxReason)
 	knetFilter.SetIngPort(l2Port.port)
@@ -73,14 +130,193 @@ func (l2Port *L2Port) Create(prio int) error {
 		return err
 	}
 
-	if err := l2Port.setupKnetFilter(opennsl.RxReasonBpdu, prio, "Catch BPDU"); err != nil {
+	l2Port.knetFilterPrio = prio
+	if err := l2Port.setupKnetFilter(opennsl.RxReasonBpdu, prio, bpduKnetFilterDesc, l2Port.bpduDest); err != nil {
 		return err
 	}
 
-	err := opennsl.PortFloodBlockSet(l2Port.asic.unit, l2Port.port, opennsl.Port(0), opennsl.PORT_FLOOD_BLOCK_UNKNOWN_UCAST)
+	// Unknown unicast received on port is not flooded to CPU
+	err := opennsl.PortFloodBlockSet(l2Port.asic.unit, l2Port.port, CPU_PORT, opennsl.PORT_FLOOD_BLOCK_UNKNOWN_UCAST)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (l2Port *L2Port) destroyKnetFilter(desc string) error {
+	filterID, exists := l2Port.knetFilters[desc]
+	if !exists {
+		return nil
+	}
+
+	if err := opennsl.KnetFilterDestroy(l2Port.asic.unit, filterID); err != nil {
+		return err
+	}
+
+	delete(l2Port.knetFilters, desc)
+	return nil
+}
+
+// replaceBpduKnetFilter recreates BPDU KNET filter with given destination and tag stripping.
+// Previous filter is created again if the new one cannot be created.
+func (l2Port *L2Port) replaceBpduKnetFilter(destType opennsl.KnetDestType, stripTag bool) error {
+	if err := l2Port.destroyKnetFilter(bpduKnetFilterDesc); err != nil {
+		log.Errorf("Failed to destroy BPDU KNET filter of port %s: %s", l2Port.portName, err)
+		return err
+	}
+
+	prevStripTag := l2Port.stripTag
+	l2Port.stripTag = stripTag
+	err := l2Port.setupKnetFilter(opennsl.RxReasonBpdu, l2Port.knetFilterPrio, bpduKnetFilterDesc, destType)
+	if err == nil {
+		l2Port.bpduDest = destType
+		return nil
+	}
+
+	log.Errorf("Failed to create BPDU KNET filter of port %s: %s", l2Port.portName, err)
+	l2Port.stripTag = prevStripTag
+	if restoreErr := l2Port.setupKnetFilter(opennsl.RxReasonBpdu, l2Port.knetFilterPrio, bpduKnetFilterDesc, l2Port.bpduDest); restoreErr != nil {
+		log.Errorf("Failed to restore BPDU KNET filter of port %s: %s", l2Port.portName, restoreErr)
+	}
+
+	return err
+}
+
+// SetBpduDestination redirects BPDUs received on port to the port netdev, to the SDK Rx API
+// or drops them.
+func (l2Port *L2Port) SetBpduDestination(destType opennsl.KnetDestType) error {
+	l2Port.mtx.Lock()
+	defer l2Port.mtx.Unlock()
+
+	if destType == l2Port.bpduDest {
+		return nil
+	}
+
+	return l2Port.replaceBpduKnetFilter(destType, l2Port.stripTag)
+}
+
+// SetStripTag tells if VLAN tag is stripped from frames delivered to the port netdev.
+func (l2Port *L2Port) SetStripTag(stripTag bool) error {
+	l2Port.mtx.Lock()
+	defer l2Port.mtx.Unlock()
+
+	if stripTag == l2Port.stripTag {
+		return nil
+	}
+
+	return l2Port.replaceBpduKnetFilter(l2Port.bpduDest, stripTag)
+}
+
+func (l2Port *L2Port) setupVlanKnetFilter(vlan opennsl.Vlan, destID int, stripTag bool) error {
+	desc := subIfaceKnetFilterDesc(vlan)
+	knetFilter := opennsl.NewKnetFilter()
+	knetFilter.SetDescription(desc)
+	knetFilter.SetType(opennsl.KNET_FILTER_T_RX_PKT)
+	knetFilter.SetFlags(knetFilterFlags(stripTag))
+	knetFilter.SetMatchFlags(opennsl.NewKnetFilterMatchFlags(
+		opennsl.KNET_FILTER_M_INGPORT,
+		opennsl.KNET_FILTER_M_VLAN,
+	))
+	knetFilter.SetDestType(opennsl.KNET_DEST_T_NETIF)
+	knetFilter.SetDestID(destID)
+	knetFilter.SetPriority(l2Port.knetFilterPrio)
+	knetFilter.SetIngPort(l2Port.port)
+	knetFilter.SetVlan(vlan)
+	if err := knetFilter.Create(l2Port.asic.unit); err != nil {
+		return err
+	}
+
+	l2Port.knetFilters[desc] = knetFilter.ID()
+	return nil
+}
+
+// knetNetdevNames returns names of port netdev and of KNET netdevs of its sub-interfaces.
+func (l2Port *L2Port) knetNetdevNames() []string {
+	l2Port.mtx.Lock()
+	defer l2Port.mtx.Unlock()
+
+	ifnames := []string{l2Port.portName}
+	for vlan, subIface := range l2Port.subIfaces {
+		if subIface.mode == SUBIF_MODE_KNET_NETDEV {
+			ifnames = append(ifnames, subIfaceName(l2Port.portName, vlan))
+		}
+	}
+
+	return ifnames
+}
+
+// AddSubIface delivers frames received on port in given VLAN to Linux, either to dedicated
+// KNET netdev or tagged to the port netdev.
+func (l2Port *L2Port) AddSubIface(vlan opennsl.Vlan, mode SubIfaceMode) error {
+	l2Port.mtx.Lock()
+	defer l2Port.mtx.Unlock()
+
+	if subIface, exists := l2Port.subIfaces[vlan]; exists {
+		if subIface.mode == mode {
+			return nil
+		}
+
+		if err := l2Port.deleteSubIface(vlan); err != nil {
+			return err
+		}
+	}
+
+	subIface := &l2PortSubIface{mode: mode, knetNetIfaceID: l2Port.knetNetIfaceID}
+	if mode == SUBIF_MODE_KNET_NETDEV {
+		knetNetIface := opennsl.NewKnetNetIface()
+		knetNetIface.SetType(opennsl.KNET_NETIF_T_TX_LOCAL_PORT)
+		knetNetIface.SetVlan(vlan)
+		knetNetIface.SetPort(l2Port.port)
+		knetNetIface.SetMAC(l2Port.macAddr)
+		knetNetIface.SetName(subIfaceName(l2Port.portName, vlan))
+		if err := knetNetIface.Create(l2Port.asic.unit); err != nil {
+			log.Errorf("Failed to create KNET netdev %s: %s", subIfaceName(l2Port.portName, vlan), err)
+			return err
+		}
+
+		subIface.knetNetIfaceID = knetNetIface.ID()
+	}
+
+	if err := l2Port.setupVlanKnetFilter(vlan, subIface.knetNetIfaceID, mode == SUBIF_MODE_KNET_NETDEV); err != nil {
+		log.Errorf("Failed to create KNET filter of VLAN %d on port %s: %s", vlan, l2Port.portName, err)
+		if mode == SUBIF_MODE_KNET_NETDEV {
+			opennsl.KnetNetIfaceDestroy(l2Port.asic.unit, subIface.knetNetIfaceID)
+		}
+
+		return err
+	}
+
+	l2Port.subIfaces[vlan] = subIface
+	return nil
+}
+
+// DeleteSubIface stops delivering frames of VLAN sub-interface to Linux.
+func (l2Port *L2Port) DeleteSubIface(vlan opennsl.Vlan) error {
+	l2Port.mtx.Lock()
+	defer l2Port.mtx.Unlock()
+
+	return l2Port.deleteSubIface(vlan)
+}
+
+func (l2Port *L2Port) deleteSubIface(vlan opennsl.Vlan) error {
+	subIface, exists := l2Port.subIfaces[vlan]
+	if !exists {
+		return nil
+	}
+
+	if err := l2Port.destroyKnetFilter(subIfaceKnetFilterDesc(vlan)); err != nil {
+		log.Errorf("Failed to destroy KNET filter of VLAN %d on port %s: %s", vlan, l2Port.portName, err)
+		return err
+	}
+
+	if subIface.mode == SUBIF_MODE_KNET_NETDEV {
+		if err := opennsl.KnetNetIfaceDestroy(l2Port.asic.unit, subIface.knetNetIfaceID); err != nil {
+			log.Errorf("Failed to destroy KNET netdev %s: %s", subIfaceName(l2Port.portName, vlan), err)
+			return err
+		}
+	}
+
+	delete(l2Port.subIfaces, vlan)
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
 const (
@@ -19,14 +18,16 @@ const (
 )
 
 type LAG struct {
-	trunk   opennsl.Trunk
-	members map[string]struct{}
+	trunk          opennsl.Trunk
+	members        map[string]struct{}
+	egressDisabled map[string]struct{}
 }
 
 func NewLAG(tid opennsl.Trunk) *LAG {
 	return &LAG{
-		trunk:   tid,
-		members: make(map[string]struct{}),
+		trunk:          tid,
+		members:        make(map[string]struct{}),
+		egressDisabled: make(map[string]struct{}),
 	}
 }
 
@@ -35,58 +36,333 @@ type lagMgmtRequest struct {
 	sw *Switch
 }
 
-func (lagMgmt *lagMgmtRequest) CreateLag(ctx context.Context, req *pb.LagIface) (*pb.RpcResult, error) {
-	lagIfname := req.GetName()
-	if _, ok := lagMgmt.sw.lagIfaces[lagIfname]; ok {
-		return &pb.RpcResult{Result: pb.RpcResult_SUCCESS}, nil
+// CreateLag creates trunk for LAG interface. Creating already existing LAG is not an error.
+func (sw *Switch) CreateLag(lagIfname string) error {
+	if iface, err := sw.ResolveIface(lagIfname); err == nil {
+		if iface.Kind == IFACE_KIND_LAG {
+			return nil
+		}
+
+		errMsg := fmt.Sprintf("Cannot create LAG %s, name is already used by %s", lagIfname, iface.Kind)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
 	}
 
-	trunk, err := opennsl.TrunkCreate(lagMgmt.sw.asic.unit, opennsl.NewTrunkFlags(opennsl.TRUNK_FLAG_NONE))
+	trunk, err := opennsl.TrunkCreate(sw.asic.unit, opennsl.NewTrunkFlags(opennsl.TRUNK_FLAG_NONE))
 	if err != nil {
 		errMsg := fmt.Sprintf("Failed to create LAG %s: %s", lagIfname, err)
 		log.Errorf(errMsg)
-		return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
 	}
 
 	// trunkInfo := opennsl.NewTrunkInfo()
 	// trunkInfo.SetDLFIndex(int(opennsl.TRUNK_UNSPEC_INDEX))
 	// trunkInfo.SetMCIndex(int(opennsl.TRUNK_UNSPEC_INDEX))
 	// trunkInfo.SetIPMCIndex(int(opennsl.TRUNK_UNSPEC_INDEX))
-	// err = trunk.MemberSet(lagMgmt.sw.asic.unit, trunkInfo, make([]opennsl.TrunkMember, 0))
+	// err = trunk.MemberSet(sw.asic.unit, trunkInfo, make([]opennsl.TrunkMember, 0))
 	// if err != nil {
-	// 	trunk.Destroy(lagMgmt.sw.asic.unit)
+	// 	trunk.Destroy(sw.asic.unit)
 	// 	errMsg := fmt.Sprintf("Failed to set trunk parameters of LAG %s: %s", lagIfname, err)
 	// 	log.Errorf(errMsg)
-	// 	return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
+	// 	return fmt.Errorf(errMsg)
 	// }
 
 	// TODO: Replace raw value of 9 with constant TRUNK_PSC_PORTFLOW
-	err = trunk.PscSet(lagMgmt.sw.asic.unit, opennsl.TrunkPsc(9))
+	err = trunk.PscSet(sw.asic.unit, opennsl.TrunkPsc(9))
 	if err != nil {
-		trunk.Destroy(lagMgmt.sw.asic.unit)
+		trunk.Destroy(sw.asic.unit)
 		errMsg := fmt.Sprintf("Failed to set PSC of LAG %s: %s", lagIfname, err)
 		log.Errorf(errMsg)
-		return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	sw.ifaceMtx.Lock()
+	sw.lagIfaces[lagIfname] = NewLAG(trunk)
+	sw.ifaceMtx.Unlock()
+
+	if err := sw.fdb.Reinstall(lagIfname); err != nil {
+		log.Warnf("Failed to install static FDB entries of LAG %s: %s", lagIfname, err)
+	}
+
+	return nil
+}
+
+// AddLagMember adds port to LAG. Port is added with egress enabled.
+func (sw *Switch) AddLagMember(lagIfname string, portName string) error {
+	iface, err := sw.ResolvePort(portName)
+	if err != nil {
+		log.Errorf("Cannot add %s to LAG %s: %s", portName, lagIfname, err)
+		return err
+	}
+
+	sw.ifaceMtx.Lock()
+	added, err := sw.addLagMember(lagIfname, portName, iface.Port)
+	sw.ifaceMtx.Unlock()
+	if err != nil || !added {
+		return err
+	}
+
+	// VLAN manager resolves interfaces under its own lock, so it is called after registry is released
+	if err := sw.vlans.addLagMember(lagIfname, iface.Port); err != nil {
+		log.Errorf("Failed to apply VLAN membership of LAG %s to port %s: %s", lagIfname, portName, err)
+	}
+
+	return nil
+}
+
+// addLagMember has to be called with interface registry locked. It reports whether port was
+// added, i.e. it was not member of LAG already.
+func (sw *Switch) addLagMember(lagIfname string, portName string, port opennsl.Port) (bool, error) {
+	lag, exists := sw.lagIfaces[lagIfname]
+	if !exists {
+		errMsg := fmt.Sprintf("LAG %s does not exist", lagIfname)
+		log.Errorf(errMsg)
+		return false, fmt.Errorf(errMsg)
+	}
+
+	if _, exists = lag.members[portName]; exists {
+		return false, nil
+	}
+
+	trunkMember := opennsl.NewTrunkMember()
+	trunkMember.SetGPort(opennsl.GPortFromLocal(port))
+	if err := sdkCall("TrunkMemberAdd", lag.trunk.MemberAdd(sw.asic.unit, trunkMember)); err != nil {
+		errMsg := fmt.Sprintf("Failed to add port %s to LAG %s: %s", portName, lagIfname, err)
+		log.Errorf(errMsg)
+		return false, fmt.Errorf(errMsg)
+	}
+
+	lag.members[portName] = struct{}{}
+	sw.mirror.lagChanged(lagIfname)
+	return true, nil
+}
+
+func (sw *Switch) trunkMember(portName string, egressDisabled bool) (*opennsl.TrunkMember, error) {
+	port, err := portByName(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	trunkMember := opennsl.NewTrunkMember()
+	trunkMember.SetGPort(opennsl.GPortFromLocal(port))
+	if egressDisabled {
+		trunkMember.SetFlags(opennsl.NewTrunkMemberFlags(opennsl.TRUNK_MEMBER_EGRESS_DISABLE))
+	}
+
+	return trunkMember, nil
+}
+
+// RemoveLagMember removes port from LAG.
+func (sw *Switch) RemoveLagMember(lagIfname string, portName string) error {
+	sw.ifaceMtx.Lock()
+	lag, exists := sw.lagIfaces[lagIfname]
+	if !exists {
+		sw.ifaceMtx.Unlock()
+		errMsg := fmt.Sprintf("LAG %s does not exist", lagIfname)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	port, removed, err := sw.removeLagMember(lag, lagIfname, portName)
+	sw.ifaceMtx.Unlock()
+	if err != nil || !removed {
+		return err
+	}
+
+	sw.releaseLagMembers(lagIfname, []opennsl.Port{port})
+	return nil
+}
+
+// removeLagMember has to be called with interface registry locked. It returns port and reports
+// whether it was removed, i.e. it was member of LAG.
+func (sw *Switch) removeLagMember(lag *LAG, lagIfname string, portName string) (opennsl.Port, bool, error) {
+	port, err := portByName(portName)
+	if err != nil {
+		return port, false, err
+	}
+
+	if _, exists := lag.members[portName]; !exists {
+		return port, false, nil
+	}
+
+	_, egressDisabled := lag.egressDisabled[portName]
+	trunkMember, err := sw.trunkMember(portName, egressDisabled)
+	if err != nil {
+		return port, false, err
+	}
+
+	if err := sdkCall("TrunkMemberDelete", lag.trunk.MemberDelete(sw.asic.unit, trunkMember)); err != nil {
+		errMsg := fmt.Sprintf("Failed to remove port %s from LAG %s: %s", portName, lagIfname, err)
+		log.Errorf(errMsg)
+		return port, false, fmt.Errorf(errMsg)
+	}
+
+	delete(lag.members, portName)
+	delete(lag.egressDisabled, portName)
+	sw.mirror.lagChanged(lagIfname)
+	return port, true, nil
+}
+
+// releaseLagMembers removes VLAN settings of LAG from ports which have left it. It has to be
+// called with interface registry unlocked.
+func (sw *Switch) releaseLagMembers(lagIfname string, ports []opennsl.Port) {
+	if err := sw.vlans.removeLagMember(lagIfname, ports); err != nil {
+		log.Errorf("Failed to remove VLAN membership of LAG %s from its former members: %s", lagIfname, err)
+	}
+}
+
+// SetLagMemberEgress enables or disables sending traffic of LAG through its member port. Member
+// with disabled egress still receives traffic, e.g. while LACP negotiation is not finished.
+func (sw *Switch) SetLagMemberEgress(lagIfname string, portName string, enabled bool) error {
+	// Registered before unlock, so it runs after interface registry is released
+	var released []opennsl.Port
+	defer func() {
+		if len(released) > 0 {
+			sw.releaseLagMembers(lagIfname, released)
+		}
+	}()
+
+	sw.ifaceMtx.Lock()
+	defer sw.ifaceMtx.Unlock()
+
+	lag, exists := sw.lagIfaces[lagIfname]
+	if !exists {
+		errMsg := fmt.Sprintf("LAG %s does not exist", lagIfname)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	if _, exists := lag.members[portName]; !exists {
+		errMsg := fmt.Sprintf("Port %s is not member of LAG %s", portName, lagIfname)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	if _, egressDisabled := lag.egressDisabled[portName]; egressDisabled == !enabled {
+		return nil
+	}
+
+	// Trunk member flags cannot be modified, so member is added again with new flags
+	prevMember, err := sw.trunkMember(portName, enabled)
+	if err != nil {
+		return err
+	}
+
+	trunkMember, err := sw.trunkMember(portName, !enabled)
+	if err != nil {
+		return err
+	}
+
+	if err := sdkCall("TrunkMemberDelete", lag.trunk.MemberDelete(sw.asic.unit, prevMember)); err != nil {
+		errMsg := fmt.Sprintf("Failed to update port %s of LAG %s: %s", portName, lagIfname, err)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	if err := sdkCall("TrunkMemberAdd", lag.trunk.MemberAdd(sw.asic.unit, trunkMember)); err != nil {
+		errMsg := fmt.Sprintf("Failed to update port %s of LAG %s: %s", portName, lagIfname, err)
+		log.Errorf(errMsg)
+		// Port is added back with previous flags, otherwise it is removed from LAG completely
+		if restoreErr := sdkCall("TrunkMemberAdd", lag.trunk.MemberAdd(sw.asic.unit, prevMember)); restoreErr != nil {
+			log.Errorf("Failed to add port %s back to LAG %s, removing it: %s", portName, lagIfname, restoreErr)
+			if port, portErr := portByName(portName); portErr == nil {
+				released = append(released, port)
+			}
+
+			delete(lag.members, portName)
+			delete(lag.egressDisabled, portName)
+			sw.mirror.lagChanged(lagIfname)
+		}
+
+		return fmt.Errorf(errMsg)
+	}
+
+	if enabled {
+		delete(lag.egressDisabled, portName)
+		log.Infof("Enabled egress of port %s in LAG %s", portName, lagIfname)
+	} else {
+		lag.egressDisabled[portName] = struct{}{}
+		log.Infof("Disabled egress of port %s in LAG %s", portName, lagIfname)
+	}
+
+	return nil
+}
+
+// LagMembers returns members of LAG with state of their egress.
+func (sw *Switch) LagMembers(lagIfname string) (map[string]bool, error) {
+	sw.ifaceMtx.RLock()
+	defer sw.ifaceMtx.RUnlock()
+
+	lag, exists := sw.lagIfaces[lagIfname]
+	if !exists {
+		return nil, fmt.Errorf("LAG %s does not exist", lagIfname)
+	}
+
+	members := make(map[string]bool)
+	for portName := range lag.members {
+		_, egressDisabled := lag.egressDisabled[portName]
+		members[portName] = !egressDisabled
+	}
+
+	return members, nil
+}
+
+// DeleteLag removes all members from LAG, flushes addresses learned on it and destroys its trunk.
+func (sw *Switch) DeleteLag(lagIfname string) error {
+	// Registered before unlock, so it runs after interface registry is released
+	var released []opennsl.Port
+	defer func() {
+		if len(released) > 0 {
+			sw.releaseLagMembers(lagIfname, released)
+		}
+	}()
+
+	sw.ifaceMtx.Lock()
+	defer sw.ifaceMtx.Unlock()
+
+	lag, exists := sw.lagIfaces[lagIfname]
+	if !exists {
+		return nil
+	}
+
+	for portName := range lag.members {
+		port, _, err := sw.removeLagMember(lag, lagIfname, portName)
+		if err != nil {
+			return err
+		}
+
+		released = append(released, port)
+	}
+
+	// Deleted addresses are reported by callbacks, so kernel FDB follows the flush
+	flags := opennsl.NewL2DeleteFlags(opennsl.L2_DELETE_PENDING)
+	if err := opennsl.L2AddrDeleteByTrunk(sw.asic.unit, lag.trunk, flags); err != nil {
+		log.Warnf("Failed to flush FDB on LAG %s: %s", lagIfname, err)
+	}
+
+	if err := lag.trunk.Destroy(sw.asic.unit); err != nil {
+		errMsg := fmt.Sprintf("Failed to destroy LAG %s: %s", lagIfname, err)
+		log.Errorf(errMsg)
+		return fmt.Errorf(errMsg)
+	}
+
+	delete(sw.lagIfaces, lagIfname)
+	return nil
+}
+
+func (lagMgmt *lagMgmtRequest) CreateLag(ctx context.Context, req *pb.LagIface) (*pb.RpcResult, error) {
+	if err := lagMgmt.sw.CreateLag(req.GetName()); err != nil {
+		return &pb.RpcResult{Result: pb.RpcResult_FAILED}, err
 	}
 
-	lagMgmt.sw.lagIfaces[lagIfname] = NewLAG(trunk)
 	return &pb.RpcResult{Result: pb.RpcResult_SUCCESS}, nil
 }
 
 // TODO: This method should set flag TRUNK_MEMBER_EGRESS_DISABLE and shoul be unset when LACP is in stae 0x3D on
 //       added ports
 func (lagMgmt *lagMgmtRequest) AddLagMembers(ctx context.Context, req *pb.LagMembers) (*pb.RpcResult, error) {
-	var lag *LAG
-	var exists bool
 	lagIfname := req.GetIface().GetName()
-	if lag, exists = lagMgmt.sw.lagIfaces[lagIfname]; !exists {
-		errMsg := fmt.Sprintf("LAG %s does not exist", lagIfname)
-		log.Errorf(errMsg)
-		return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
-	}
-
 	log.Printf("Adding ports to LAG %s", lagIfname)
-	var portIdx uint16
 	portMembers := req.GetMembers()
 	if portMembers != nil {
 		log.Printf("Number of ports: %d", len(portMembers))
@@ -104,31 +380,10 @@ func (lagMgmt *lagMgmtRequest) AddLagMembers(ctx context.Context, req *pb.LagMem
 			break
 		}
 		log.Printf("Adding port %s", portName)
-		if _, exists = lag.members[portName]; exists {
-			log.Printf("Port already exists in LAG")
-			// If port is already attached to trunk, take appropriate action.
-			// BCM API doesn't check for duplicate ports in trunk.
-			continue
-		}
-
-		if portIdx, exists = NamePortIdxMap[member.GetName()]; !exists {
-			errMsg := fmt.Sprintf("Port %s does not exist", member.GetName())
-			log.Errorf(errMsg)
-			return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
-		}
-
-		port := PortNames[portIdx].Port
-		gport := opennsl.GPortFromLocal(port)
-		trunkMember := opennsl.NewTrunkMember()
-		trunkMember.SetGPort(gport)
-		if err := lag.trunk.MemberAdd(lagMgmt.sw.asic.unit, trunkMember); err != nil {
-			// TODO: Let's rollback already added ports to LAG
-			errMsg := fmt.Sprintf("Port %s does not exist", member.GetName())
-			log.Errorf(errMsg)
-			return &pb.RpcResult{Result: pb.RpcResult_FAILED}, fmt.Errorf(errMsg)
+		// TODO: Let's rollback already added ports to LAG
+		if err := lagMgmt.sw.AddLagMember(lagIfname, portName); err != nil {
+			return &pb.RpcResult{Result: pb.RpcResult_FAILED}, err
 		}
-
-		lag.members[portName] = struct{}{}
 	}
 
 	return &pb.RpcResult{Result: pb.RpcResult_SUCCESS}, nil
@@ -139,7 +394,7 @@ func HandleLAGRequest(sw *Switch) {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
+	s := newGrpcServer()
 	pb.RegisterLagManagementServer(s, &lagMgmtRequest{sw: sw})
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
@@ -0,0 +1,161 @@
+package bcm
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	LAG_SYNC_INTERVAL = 30 * time.Second
+)
+
+// lagSync creates LAGs for kernel team and bond netdevs which enslave netdevs of switch
+// ports. LAGs created by teamd plugin over gRPC are left untouched.
+type lagSync struct {
+	sw      *Switch
+	owned   map[string]struct{}
+	trigger netlinkTrigger
+}
+
+func newLagSync(sw *Switch) *lagSync {
+	return &lagSync{
+		sw:      sw,
+		owned:   make(map[string]struct{}),
+		trigger: newNetlinkTrigger(),
+	}
+}
+
+func isLagLink(link netlink.Link) bool {
+	return link.Type() == "team" || link.Type() == "bond"
+}
+
+// slaveActive tells if LAG may send traffic through the slave. Bond reports state of
+// its slaves. Team does not expose it over rtnetlink, so operational state of the slave is used.
+func slaveActive(link netlink.Link) bool {
+	if bondSlave, ok := link.Attrs().Slave.(*netlink.BondSlave); ok {
+		return bondSlave.State == netlink.BondStateActive && bondSlave.MiiStatus == netlink.BondLinkUp
+	}
+
+	return link.Attrs().OperState == netlink.OperUp
+}
+
+func (ls *lagSync) sync() error {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return err
+	}
+
+	lagNames := make(map[int]string)
+	desired := make(map[string]map[string]bool)
+	for _, link := range links {
+		if isLagLink(link) {
+			lagNames[link.Attrs().Index] = link.Attrs().Name
+			desired[link.Attrs().Name] = make(map[string]bool)
+		}
+	}
+
+	for _, link := range links {
+		portName := link.Attrs().Name
+		if _, err := portByName(portName); err != nil {
+			continue
+		}
+
+		if lagIfname, exists := lagNames[link.Attrs().MasterIndex]; exists {
+			desired[lagIfname][portName] = slaveActive(link)
+		}
+	}
+
+	for lagIfname := range ls.owned {
+		if _, exists := desired[lagIfname]; exists {
+			continue
+		}
+
+		log.Infof("Netdev %s has been removed, deleting its LAG", lagIfname)
+		if err := ls.sw.DeleteLag(lagIfname); err != nil {
+			log.Errorf("Failed to delete LAG %s: %s", lagIfname, err)
+			continue
+		}
+
+		delete(ls.owned, lagIfname)
+	}
+
+	for lagIfname, members := range desired {
+		if _, exists := ls.owned[lagIfname]; !exists {
+			// LAG without front panel ports is not interesting for hardware
+			if len(members) == 0 {
+				continue
+			}
+
+			if _, err := ls.sw.ResolveIface(lagIfname); err == nil {
+				log.Debugf("Interface %s already exists, not following netdev %s", lagIfname, lagIfname)
+				continue
+			}
+
+			log.Infof("Creating LAG for netdev %s", lagIfname)
+			if err := ls.sw.CreateLag(lagIfname); err != nil {
+				continue
+			}
+
+			ls.owned[lagIfname] = struct{}{}
+		}
+
+		ls.syncMembers(lagIfname, members)
+	}
+
+	return nil
+}
+
+func (ls *lagSync) syncMembers(lagIfname string, desired map[string]bool) {
+	current, err := ls.sw.LagMembers(lagIfname)
+	if err != nil {
+		log.Errorf("%s", err)
+		return
+	}
+
+	for portName := range current {
+		if _, exists := desired[portName]; exists {
+			continue
+		}
+
+		log.Infof("Port %s has been released from %s", portName, lagIfname)
+		if err := ls.sw.RemoveLagMember(lagIfname, portName); err != nil {
+			continue
+		}
+
+		delete(current, portName)
+	}
+
+	for portName, active := range desired {
+		egressEnabled, exists := current[portName]
+		if !exists {
+			log.Infof("Port %s has been enslaved by %s", portName, lagIfname)
+			if err := ls.sw.AddLagMember(lagIfname, portName); err != nil {
+				continue
+			}
+
+			egressEnabled = true
+		}
+
+		if egressEnabled != active {
+			ls.sw.SetLagMemberEgress(lagIfname, portName, active)
+		}
+	}
+}
+
+func (ls *lagSync) run() {
+	ls.trigger.run(LAG_SYNC_INTERVAL, func() {
+		if err := ls.sync(); err != nil {
+			log.Errorf("Failed to sync LAGs with kernel: %s", err)
+		}
+	})
+}
+
+// StartLagSync starts following kernel team and bond netdevs with LAGs in hardware.
+func (sw *Switch) StartLagSync() {
+	log.Infof("Starting sync of kernel team and bond devices into LAGs")
+	ls := newLagSync(sw)
+	go watchLinkChanges(ls.trigger.notify)
+	go ls.run()
+}
@@ -0,0 +1,142 @@
+package bcm
+
+import (
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+// LearningMode tells how hardware MAC learning of port is controlled.
+type LearningMode int
+
+const (
+	// Learning is enabled only in STP learning and forwarding states
+	LEARNING_FOLLOW_STP LearningMode = iota
+	LEARNING_ALWAYS_ENABLED
+	LEARNING_ALWAYS_DISABLED
+)
+
+// LearningPolicy represents per port override of hardware MAC learning.
+type LearningPolicy struct {
+	Mode LearningMode `json:"mode"`
+	// Remove learned addresses when STP stops learning on port
+	FlushOnBlock bool `json:"flushOnBlock"`
+}
+
+func stpStateLearns(stpState opennsl.StgStp) bool {
+	return stpState == opennsl.STG_STP_LEARN || stpState == opennsl.STG_STP_FORWARD
+}
+
+func (sw *Switch) learningPolicy(portName string) LearningPolicy {
+	var policy LearningPolicy
+	sw.cfg.view(func(cfg *Config) {
+		policy = cfg.LearningPolicy[portName]
+	})
+
+	return policy
+}
+
+// applyLearning enables or disables hardware MAC learning on port according to
+// its STP state and learning policy. Port security lock takes precedence over both.
+func (sw *Switch) applyLearning(portName string, port opennsl.Port, stpState opennsl.StgStp) error {
+	if locked, flags := sw.portSecurity.learningLock(portName); locked {
+		log.Debugf("MAC learning on port %s is locked by port security", portName)
+		if err := opennsl.PortLearnSet(sw.asic.unit, port, flags); err != nil {
+			log.Errorf("Failed to lock MAC learning on port %s (%d): %s", portName, port, err)
+			return err
+		}
+
+		return nil
+	}
+
+	policy := sw.learningPolicy(portName)
+	var learn bool
+	switch policy.Mode {
+	case LEARNING_ALWAYS_ENABLED:
+		learn = true
+	case LEARNING_ALWAYS_DISABLED:
+		learn = false
+	default:
+		learn = stpStateLearns(stpState)
+	}
+
+	flags := opennsl.NewPortLearnFlags(opennsl.PORT_LEARN_FWD)
+	if learn {
+		flags = opennsl.NewPortLearnFlags(opennsl.PORT_LEARN_ARL, opennsl.PORT_LEARN_FWD)
+	}
+
+	log.Debugf("Setting MAC learning on port %s to %t (STP state %d)", portName, learn, stpState)
+	if err := opennsl.PortLearnSet(sw.asic.unit, port, flags); err != nil {
+		log.Errorf("Failed to set MAC learning on port %s (%d): %s", portName, port, err)
+		return err
+	}
+
+	if learn || !policy.FlushOnBlock {
+		return nil
+	}
+
+	log.Infof("Flushing learned MAC addresses on port %s", portName)
+	err := opennsl.L2AddrDeleteByPort(sw.asic.unit, opennsl.Module(-1), port, opennsl.NewL2DeleteFlags(opennsl.L2_DELETE_PENDING))
+	if err != nil {
+		log.Errorf("Failed to flush learned MAC addresses on port %s (%d): %s", portName, port, err)
+		return err
+	}
+
+	return nil
+}
+
+// setLearningPolicy stores learning policy of port and applies it for current STP state of port.
+func (sw *Switch) setLearningPolicy(portName string, policy LearningPolicy) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	if err := sw.cfg.update(func(cfg *Config) {
+		if policy == (LearningPolicy{}) {
+			delete(cfg.LearningPolicy, portName)
+		} else {
+			cfg.LearningPolicy[portName] = policy
+		}
+	}); err != nil {
+		return err
+	}
+
+	return sw.refreshLearning(portName, port)
+}
+
+func (sw *Switch) refreshLearning(portName string, port opennsl.Port) error {
+	stg, err := opennsl.StpDefaultGet(sw.asic.unit)
+	if err != nil {
+		return err
+	}
+
+	stpState, err := stg.StpGet(sw.asic.unit, port)
+	if err != nil {
+		log.Errorf("Failed to get STP state of port %s (%d): %s", portName, port, err)
+		return err
+	}
+
+	return sw.applyLearning(portName, port, stpState)
+}
+
+func (sw *Switch) restoreLearningPolicies() error {
+	var portNames []string
+	sw.cfg.view(func(cfg *Config) {
+		for portName := range cfg.LearningPolicy {
+			portNames = append(portNames, portName)
+		}
+	})
+
+	for _, portName := range portNames {
+		port, err := portByName(portName)
+		if err == nil {
+			err = sw.refreshLearning(portName, port)
+		}
+
+		if err != nil {
+			log.Errorf("Failed to restore MAC learning policy of port %s: %s", portName, err)
+		}
+	}
+
+	return nil
+}
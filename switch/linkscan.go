@@ -0,0 +1,190 @@
+package bcm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	LINKSCAN_INTERVAL_USEC = 250000
+	// bcm-knet module sets carrier of its netdev on "<name>=up" or "<name>=down" written there
+	knetLinkProcFile = "/proc/bcm/knet/link"
+)
+
+type linkChange struct {
+	portName string
+	up       bool
+}
+
+// LinkEvent informs about link of port going up or down.
+type LinkEvent struct {
+	PortName string
+	Up       bool
+	Time     time.Time
+}
+
+// portLink keeps link state of port. Flaps count transitions of link from up to down.
+type portLink struct {
+	up    bool
+	since time.Time
+	flaps uint64
+}
+
+// linkMonitor follows link state reported by SDK linkscan and reflects it in carrier of
+// KNET netdevs, so Linux daemons see link going down. Linkscan callback only records the
+// latest state of port in pending and signals run, so it never waits for processing.
+type linkMonitor struct {
+	sw         *Switch
+	mtx        sync.Mutex
+	links      map[string]*portLink
+	pendingMtx sync.Mutex
+	pending    map[string]bool
+	signal     chan struct{}
+}
+
+func newLinkMonitor(sw *Switch) *linkMonitor {
+	return &linkMonitor{
+		sw:      sw,
+		links:   make(map[string]*portLink),
+		pending: make(map[string]bool),
+		signal:  make(chan struct{}, 1),
+	}
+}
+
+func setKnetCarrier(ifname string, up bool) error {
+	state := "down"
+	if up {
+		state = "up"
+	}
+
+	return ioutil.WriteFile(knetLinkProcFile, []byte(fmt.Sprintf("%s=%s", ifname, state)), 0644)
+}
+
+// handleLinkChange is called from SDK linkscan thread, so netdevs are updated by run.
+func (monitor *linkMonitor) handleLinkChange(unit int, port opennsl.Port, info *opennsl.PortInfo) {
+	portName, err := portNameOf(port)
+	if err != nil {
+		return
+	}
+
+	monitor.pendingMtx.Lock()
+	monitor.pending[portName] = info.LinkStatus() == opennsl.PORT_LINK_STATUS_UP
+	monitor.pendingMtx.Unlock()
+
+	select {
+	case monitor.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (monitor *linkMonitor) run() {
+	for range monitor.signal {
+		monitor.pendingMtx.Lock()
+		pending := monitor.pending
+		monitor.pending = make(map[string]bool)
+		monitor.pendingMtx.Unlock()
+
+		for portName, up := range pending {
+			monitor.apply(linkChange{portName: portName, up: up})
+		}
+	}
+}
+
+func (monitor *linkMonitor) apply(change linkChange) {
+	now := time.Now()
+	monitor.mtx.Lock()
+	link, known := monitor.links[change.portName]
+	if known && link.up == change.up {
+		monitor.mtx.Unlock()
+		return
+	}
+
+	if !known {
+		link = &portLink{}
+		monitor.links[change.portName] = link
+	} else if !change.up {
+		link.flaps++
+	}
+
+	link.up = change.up
+	link.since = now
+	monitor.mtx.Unlock()
+
+	if change.up {
+		log.Infof("Link of port %s is up", change.portName)
+	} else {
+		log.Infof("Link of port %s is down", change.portName)
+	}
+
+	if l2Port, err := monitor.sw.l2Port(change.portName); err == nil {
+		for _, ifname := range l2Port.knetNetdevNames() {
+			monitor.setCarrier(ifname, change.up)
+		}
+	}
+
+	monitor.sw.linkEvents.publish(&LinkEvent{PortName: change.portName, Up: change.up, Time: now})
+}
+
+func (monitor *linkMonitor) setCarrier(ifname string, up bool) {
+	if err := setKnetCarrier(ifname, up); err != nil {
+		log.Errorf("Failed to set carrier of netdev %s: %s", ifname, err)
+	}
+}
+
+// linkUp tells last link state of port reported by linkscan.
+func (monitor *linkMonitor) linkUp(portName string) bool {
+	monitor.mtx.Lock()
+	defer monitor.mtx.Unlock()
+
+	link, known := monitor.links[portName]
+	return known && link.up
+}
+
+// link returns copy of link state of port.
+func (monitor *linkMonitor) link(portName string) portLink {
+	monitor.mtx.Lock()
+	defer monitor.mtx.Unlock()
+
+	if link, known := monitor.links[portName]; known {
+		return *link
+	}
+
+	return portLink{}
+}
+
+// StartLinkscan enables SDK linkscan on front panel ports and sets carrier of KNET netdevs
+// according to link state.
+func (sw *Switch) StartLinkscan() error {
+	for _, portNameMap := range PortNames {
+		if err := opennsl.LinkscanModeSet(sw.asic.unit, portNameMap.Port, opennsl.LINKSCAN_MODE_SW); err != nil {
+			log.Errorf("Failed to set linkscan mode of port %s: %s", portNameMap.PortName, err)
+			return err
+		}
+
+		status, err := opennsl.PortLinkStatusGet(sw.asic.unit, portNameMap.Port)
+		if err != nil {
+			log.Errorf("Failed to get link status of port %s: %s", portNameMap.PortName, err)
+			return err
+		}
+
+		sw.links.apply(linkChange{portName: portNameMap.PortName, up: status == opennsl.PORT_LINK_STATUS_UP})
+	}
+
+	go sw.links.run()
+	if err := opennsl.LinkscanRegister(sw.asic.unit, sw.links.handleLinkChange); err != nil {
+		log.Errorf("Failed to register linkscan callback: %s", err)
+		return err
+	}
+
+	if err := opennsl.LinkscanEnableSet(sw.asic.unit, LINKSCAN_INTERVAL_USEC); err != nil {
+		log.Errorf("Failed to enable linkscan: %s", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,514 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchFdb/gRPCServices"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_MAC_FLAP_MAX_MOVES  = 5
+	DEFAULT_MAC_FLAP_WINDOW_SEC = 10
+	macMoveStatsRetention       = time.Hour
+)
+
+// MacFlapDetectionConfig represents when moves of L2 address are reported as flapping.
+type MacFlapDetectionConfig struct {
+	Disabled  bool   `json:"disabled,omitempty"`
+	MaxMoves  uint32 `json:"maxMoves"`
+	WindowSec uint32 `json:"windowSec"`
+}
+
+// MacFlapPortAction tells if port or LAG may be blocked when address flaps over it.
+type MacFlapPortAction struct {
+	Block    bool   `json:"block"`
+	BlockSec uint32 `json:"blockSec,omitempty"`
+}
+
+// MacFlapEvent informs that L2 address moves between interfaces too often, which
+// is usually caused by a loop.
+type MacFlapEvent struct {
+	MAC           net.HardwareAddr
+	Vlan          opennsl.Vlan
+	Ifnames       []string
+	Moves         uint32
+	BlockedIfname string
+	Time          time.Time
+}
+
+type macMoveStats struct {
+	mac      net.HardwareAddr
+	vlan     opennsl.Vlan
+	recent   []time.Time
+	moves    uint64
+	flaps    uint64
+	lastFrom string
+	lastTo   string
+	lastMove time.Time
+}
+
+type macFlapBlock struct {
+	stg        opennsl.Stg
+	prevStates []stpPortState
+	unblock    *time.Timer
+}
+
+// macFlapDetector follows station moves, keeps their statistics and reports flapping addresses.
+type macFlapDetector struct {
+	sw        *Switch
+	mtx       sync.Mutex
+	stats     map[string]*macMoveStats
+	lastPrune time.Time
+	blocked   map[string]*macFlapBlock
+}
+
+func newMacFlapDetector(sw *Switch) *macFlapDetector {
+	return &macFlapDetector{
+		sw:      sw,
+		stats:   make(map[string]*macMoveStats),
+		blocked: make(map[string]*macFlapBlock),
+	}
+}
+
+func (det *macFlapDetector) detectionConfig() MacFlapDetectionConfig {
+	cfg := MacFlapDetectionConfig{
+		MaxMoves:  DEFAULT_MAC_FLAP_MAX_MOVES,
+		WindowSec: DEFAULT_MAC_FLAP_WINDOW_SEC,
+	}
+
+	det.sw.cfg.view(func(c *Config) {
+		if c.MacFlapDetection != nil {
+			cfg = *c.MacFlapDetection
+		}
+	})
+
+	return cfg
+}
+
+func (det *macFlapDetector) portAction(ifname string) MacFlapPortAction {
+	var action MacFlapPortAction
+	det.sw.cfg.view(func(c *Config) {
+		action = c.MacFlapActions[ifname]
+	})
+
+	return action
+}
+
+// prune forgets statistics of addresses which have not moved for a long time.
+func (det *macFlapDetector) prune(now time.Time) {
+	if now.Sub(det.lastPrune) < time.Minute {
+		return
+	}
+
+	det.lastPrune = now
+	for key, stats := range det.stats {
+		if now.Sub(stats.lastMove) > macMoveStatsRetention {
+			delete(det.stats, key)
+		}
+	}
+}
+
+func (det *macFlapDetector) handleMove(event *L2AddrEvent) {
+	cfg := det.detectionConfig()
+	now := time.Now()
+	key := fdbEntryKey(event.MAC.String(), uint16(event.Vlan))
+	det.mtx.Lock()
+	stats, exists := det.stats[key]
+	if !exists {
+		stats = &macMoveStats{mac: event.MAC, vlan: event.Vlan}
+		det.stats[key] = stats
+	}
+
+	stats.moves++
+	stats.lastFrom = event.PrevIfname
+	stats.lastTo = event.Ifname
+	stats.lastMove = now
+
+	windowStart := now.Add(-time.Duration(cfg.WindowSec) * time.Second)
+	recent := stats.recent[:0]
+	for _, moved := range stats.recent {
+		if moved.After(windowStart) {
+			recent = append(recent, moved)
+		}
+	}
+
+	stats.recent = append(recent, now)
+	moves := uint32(len(stats.recent))
+	flapping := !cfg.Disabled && moves > cfg.MaxMoves
+	if flapping {
+		stats.flaps++
+		// Start counting again, so flapping address is reported once per window
+		stats.recent = nil
+	}
+
+	det.prune(now)
+	det.mtx.Unlock()
+
+	if !flapping {
+		return
+	}
+
+	ifnames := []string{event.Ifname}
+	if len(event.PrevIfname) > 0 {
+		ifnames = append(ifnames, event.PrevIfname)
+	}
+
+	log.Warnf("MAC address %s in VLAN %d is flapping between %v, %d moves within %d s, possible L2 loop",
+		event.MAC, event.Vlan, ifnames, moves, cfg.WindowSec)
+	flapEvent := &MacFlapEvent{
+		MAC:     event.MAC,
+		Vlan:    event.Vlan,
+		Ifnames: ifnames,
+		Moves:   moves,
+		Time:    now,
+	}
+
+	for _, ifname := range ifnames {
+		action := det.portAction(ifname)
+		if !action.Block {
+			continue
+		}
+
+		if err := det.block(ifname, time.Duration(action.BlockSec)*time.Second); err != nil {
+			log.Errorf("Failed to block %s because of flapping address %s: %s", ifname, event.MAC, err)
+			continue
+		}
+
+		flapEvent.BlockedIfname = ifname
+		break
+	}
+
+	det.sw.macFlapEvents.publish(flapEvent)
+}
+
+// block puts ports of the interface into STP blocking state. They are brought back to
+// their previous state after blockFor period, unless it is zero. Block is dropped once STP
+// sets state of any of the ports.
+func (det *macFlapDetector) block(ifname string, blockFor time.Duration) error {
+	iface, err := det.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	stg, err := opennsl.StpDefaultGet(det.sw.asic.unit)
+	if err != nil {
+		return err
+	}
+
+	det.sw.stpMtx.Lock()
+	defer det.sw.stpMtx.Unlock()
+
+	det.mtx.Lock()
+	defer det.mtx.Unlock()
+
+	if _, exists := det.blocked[ifname]; exists {
+		return nil
+	}
+
+	block := &macFlapBlock{stg: stg}
+	for _, portName := range iface.Members {
+		if err := det.blockPort(block, portName); err != nil {
+			if rollbackErr := det.restore(block); rollbackErr != nil {
+				log.Errorf("Failed to roll back block of %s: %s", ifname, rollbackErr)
+			}
+
+			return err
+		}
+	}
+
+	log.Warnf("Blocked %s %s because of flapping address", iface.Kind, ifname)
+	if blockFor > 0 {
+		block.unblock = time.AfterFunc(blockFor, func() {
+			if err := det.unblock(ifname, block); err != nil {
+				log.Errorf("Failed to unblock %s: %s", ifname, err)
+			}
+		})
+	}
+
+	det.blocked[ifname] = block
+	return nil
+}
+
+func (det *macFlapDetector) blockPort(block *macFlapBlock, portName string) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	prevState, err := block.stg.StpGet(det.sw.asic.unit, port)
+	if err != nil {
+		return err
+	}
+
+	if err := sdkCall("StgStpSet", block.stg.StpSet(det.sw.asic.unit, port, opennsl.STG_STP_BLOCK)); err != nil {
+		return err
+	}
+
+	// Port is recorded before learning is changed, so it is restored on rollback as well
+	block.prevStates = append(block.prevStates, stpPortState{portName: portName, port: port, stg: block.stg, state: prevState})
+	return det.sw.applyLearning(portName, port, opennsl.STG_STP_BLOCK)
+}
+
+// restore brings ports back to the state before block. Ports whose state is no longer the
+// blocking one set by the detector are left untouched. It has to be called with STP lock held.
+func (det *macFlapDetector) restore(block *macFlapBlock) error {
+	for i := len(block.prevStates) - 1; i >= 0; i-- {
+		prevState := block.prevStates[i]
+		state, err := block.stg.StpGet(det.sw.asic.unit, prevState.port)
+		if err != nil {
+			return err
+		}
+
+		if state != opennsl.STG_STP_BLOCK {
+			continue
+		}
+
+		if err := sdkCall("StgStpSet", block.stg.StpSet(det.sw.asic.unit, prevState.port, prevState.state)); err != nil {
+			return err
+		}
+
+		if err := det.sw.applyLearning(prevState.portName, prevState.port, prevState.state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unblock restores ports blocked by given block. It does nothing if the block has been
+// dropped or replaced in the meantime.
+func (det *macFlapDetector) unblock(ifname string, block *macFlapBlock) error {
+	det.sw.stpMtx.Lock()
+	defer det.sw.stpMtx.Unlock()
+
+	det.mtx.Lock()
+	defer det.mtx.Unlock()
+
+	if current, exists := det.blocked[ifname]; !exists || current != block {
+		return nil
+	}
+
+	if err := det.restore(block); err != nil {
+		return err
+	}
+
+	if block.unblock != nil {
+		block.unblock.Stop()
+	}
+
+	delete(det.blocked, ifname)
+	log.Infof("Unblocked %s blocked because of flapping address", ifname)
+	return nil
+}
+
+// Unblock restores state of interface blocked because of flapping address before its block
+// period expires.
+func (det *macFlapDetector) Unblock(ifname string) error {
+	det.mtx.Lock()
+	block, exists := det.blocked[ifname]
+	det.mtx.Unlock()
+	if !exists {
+		return fmt.Errorf("%s is not blocked because of flapping address", ifname)
+	}
+
+	return det.unblock(ifname, block)
+}
+
+// stpChanged drops blocks of interfaces with the port, as STP has taken over its state. It has
+// to be called with STP lock held.
+func (det *macFlapDetector) stpChanged(portName string) {
+	det.mtx.Lock()
+	defer det.mtx.Unlock()
+
+	for ifname, block := range det.blocked {
+		for _, prevState := range block.prevStates {
+			if prevState.portName != portName {
+				continue
+			}
+
+			if block.unblock != nil {
+				block.unblock.Stop()
+			}
+
+			delete(det.blocked, ifname)
+			log.Infof("STP has set state of %s, dropping its block because of flapping address", ifname)
+			break
+		}
+	}
+}
+
+func containsIfname(ifnames []string, ifname string) bool {
+	for _, name := range ifnames {
+		if name == ifname {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (det *macFlapDetector) run(events chan interface{}) {
+	for ev := range events {
+		if event := ev.(*L2AddrEvent); event.Type == L2_ADDR_MOVE {
+			det.handleMove(event)
+		}
+	}
+}
+
+func (det *macFlapDetector) start() {
+	go det.run(det.sw.l2Events.subscribe())
+}
+
+func (fdbMgmt *fdbRequestMgmt) SetMacFlapDetection(ctx context.Context, req *pb.MacFlapDetection) (*pb.FdbResult, error) {
+	log.Infof("SetMacFlapDetection: enabled %t, max moves %d, window %d s", req.GetEnabled(), req.GetMaxMoves(), req.GetWindowSec())
+	if req.GetEnabled() && (req.GetMaxMoves() == 0 || req.GetWindowSec() == 0) {
+		errMsg := fmt.Sprintf("Number of moves and window of MAC flap detection have to be positive")
+		log.Errorf(errMsg)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, fmt.Errorf(errMsg)
+	}
+
+	cfg := &MacFlapDetectionConfig{
+		Disabled:  !req.GetEnabled(),
+		MaxMoves:  req.GetMaxMoves(),
+		WindowSec: req.GetWindowSec(),
+	}
+
+	if err := fdbMgmt.sw.cfg.update(func(c *Config) {
+		c.MacFlapDetection = cfg
+	}); err != nil {
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	return &pb.FdbResult{Result: pb.FdbResult_SUCCESS}, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) GetMacFlapDetection(ctx context.Context, req *pb.FdbEmpty) (*pb.MacFlapDetection, error) {
+	cfg := fdbMgmt.sw.macFlapDetector.detectionConfig()
+	return &pb.MacFlapDetection{
+		Enabled:   !cfg.Disabled,
+		MaxMoves:  cfg.MaxMoves,
+		WindowSec: cfg.WindowSec,
+	}, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) SetMacFlapPortAction(ctx context.Context, req *pb.MacFlapPortAction) (*pb.FdbResult, error) {
+	ifname := req.GetIfname()
+	log.Infof("SetMacFlapPortAction: Ifname %s, block %t for %d s", ifname, req.GetBlock(), req.GetBlockSec())
+	if _, err := fdbMgmt.sw.ResolveL2Iface(ifname); err != nil {
+		log.Errorf("%s", err)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	action := MacFlapPortAction{Block: req.GetBlock(), BlockSec: req.GetBlockSec()}
+	if err := fdbMgmt.sw.cfg.update(func(c *Config) {
+		if action == (MacFlapPortAction{}) {
+			delete(c.MacFlapActions, ifname)
+		} else {
+			c.MacFlapActions[ifname] = action
+		}
+	}); err != nil {
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	return &pb.FdbResult{Result: pb.FdbResult_SUCCESS}, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) UnblockMacFlapIface(ctx context.Context, req *pb.MacFlapIface) (*pb.FdbResult, error) {
+	ifname := req.GetIfname()
+	log.Infof("UnblockMacFlapIface: Ifname %s", ifname)
+	if err := fdbMgmt.sw.macFlapDetector.Unblock(ifname); err != nil {
+		log.Errorf("Failed to unblock %s: %s", ifname, err)
+		return &pb.FdbResult{Result: pb.FdbResult_FAILED}, err
+	}
+
+	return &pb.FdbResult{Result: pb.FdbResult_SUCCESS}, nil
+}
+
+func (fdbMgmt *fdbRequestMgmt) GetMoveStats(ctx context.Context, filter *pb.FdbFilter) (*pb.MacMoveStatsList, error) {
+	var hwAddr net.HardwareAddr
+	if len(filter.GetMac()) > 0 {
+		var err error
+		if hwAddr, err = net.ParseMAC(filter.GetMac()); err != nil {
+			log.Errorf("Invalid MAC address %s: %s", filter.GetMac(), err)
+			return nil, err
+		}
+	}
+
+	ifname := filter.GetIfname()
+	det := fdbMgmt.sw.macFlapDetector
+	det.mtx.Lock()
+	defer det.mtx.Unlock()
+
+	result := &pb.MacMoveStatsList{}
+	for _, stats := range det.stats {
+		if len(ifname) > 0 && stats.lastFrom != ifname && stats.lastTo != ifname {
+			continue
+		}
+
+		if filter.GetVlan() != 0 && uint32(stats.vlan) != filter.GetVlan() {
+			continue
+		}
+
+		if hwAddr != nil && !bytes.Equal(hwAddr, stats.mac) {
+			continue
+		}
+
+		result.Stats = append(result.Stats, &pb.MacMoveStats{
+			Mac:               stats.mac.String(),
+			Vlan:              uint32(stats.vlan),
+			Moves:             stats.moves,
+			Flaps:             stats.flaps,
+			LastFromIfname:    stats.lastFrom,
+			LastToIfname:      stats.lastTo,
+			LastMoveTimestamp: stats.lastMove.Unix(),
+		})
+	}
+
+	sort.Slice(result.Stats, func(i, j int) bool {
+		return result.Stats[i].Moves > result.Stats[j].Moves
+	})
+
+	return result, nil
+}
+
+// WatchMacFlaps streams flapping addresses seen on given interface or on any interface if name is empty.
+func (fdbMgmt *fdbRequestMgmt) WatchMacFlaps(filter *pb.FdbFilter, stream pb.FdbManagement_WatchMacFlapsServer) error {
+	ifname := filter.GetIfname()
+	events := fdbMgmt.sw.macFlapEvents.subscribe()
+	defer fdbMgmt.sw.macFlapEvents.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev := <-events:
+			event := ev.(*MacFlapEvent)
+			if len(ifname) > 0 && !containsIfname(event.Ifnames, ifname) {
+				continue
+			}
+
+			if filter.GetVlan() != 0 && uint32(event.Vlan) != filter.GetVlan() {
+				continue
+			}
+
+			if err := stream.Send(&pb.MacFlapEvent{
+				Mac:           event.MAC.String(),
+				Vlan:          uint32(event.Vlan),
+				Ifnames:       event.Ifnames,
+				Moves:         event.Moves,
+				BlockedIfname: event.BlockedIfname,
+				Timestamp:     event.Time.Unix(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
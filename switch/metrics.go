@@ -0,0 +1,248 @@
+package bcm
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metricsNamespace = "bcm_switch"
+)
+
+// Daemon metrics are counted even if metrics are not exported.
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "rpc_requests_total",
+		Help:      "gRPC requests handled by the daemon.",
+	}, []string{"method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Time of handling gRPC requests, whole stream for streaming requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	sdkErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sdk_errors_total",
+		Help:      "SDK calls which returned error.",
+	}, []string{"call"})
+	rxPackets = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "rx_packets_total",
+		Help:      "Packets passed to Rx handlers of the daemon.",
+	}, []string{"handler", "handled"})
+)
+
+// sdkCall counts failed SDK calls and returns their error.
+func sdkCall(call string, err error) error {
+	if err != nil {
+		sdkErrors.WithLabelValues(call).Inc()
+	}
+
+	return err
+}
+
+func observeRpc(method string, start time.Time, err error) {
+	rpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func unaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRpc(info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamMetricsInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	observeRpc(info.FullMethod, start, err)
+	return err
+}
+
+// newGrpcServer creates gRPC server which counts handled requests.
+func newGrpcServer() *grpc.Server {
+	return grpc.NewServer(
+		grpc.UnaryInterceptor(unaryMetricsInterceptor),
+		grpc.StreamInterceptor(streamMetricsInterceptor),
+	)
+}
+
+// Metric names of port counters in order of PortCounter
+var portCounterNames = []string{
+	"in_octets_total",
+	"in_ucast_pkts_total",
+	"in_multicast_pkts_total",
+	"in_broadcast_pkts_total",
+	"in_discards_total",
+	"in_errors_total",
+	"in_fcs_errors_total",
+	"out_octets_total",
+	"out_ucast_pkts_total",
+	"out_multicast_pkts_total",
+	"out_broadcast_pkts_total",
+	"out_discards_total",
+	"out_errors_total",
+	"pkts_64_octets_total",
+	"pkts_65_to_127_octets_total",
+	"pkts_128_to_255_octets_total",
+	"pkts_256_to_511_octets_total",
+	"pkts_512_to_1023_octets_total",
+	"pkts_1024_to_1518_octets_total",
+	"pkts_1519_to_max_octets_total",
+}
+
+var stgStpNames = map[opennsl.StgStp]string{
+	opennsl.STG_STP_DISABLE: "disabled",
+	opennsl.STG_STP_BLOCK:   "blocking",
+	opennsl.STG_STP_LISTEN:  "listening",
+	opennsl.STG_STP_LEARN:   "learning",
+	opennsl.STG_STP_FORWARD: "forwarding",
+}
+
+// switchCollector reads state of ports, LAGs and STP when metrics are scraped.
+type switchCollector struct {
+	sw               *Switch
+	portCounters     []*prometheus.Desc
+	linkUp           *prometheus.Desc
+	linkFlaps        *prometheus.Desc
+	lagMembers       *prometheus.Desc
+	lagMemberEnabled *prometheus.Desc
+	stpPortState     *prometheus.Desc
+}
+
+func newSwitchCollector(sw *Switch) *switchCollector {
+	collector := &switchCollector{
+		sw: sw,
+		linkUp: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "port", "link_up"),
+			"Link state of port.", []string{"port"}, nil),
+		linkFlaps: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "port", "link_flaps_total"),
+			"Transitions of link from up to down.", []string{"port"}, nil),
+		lagMembers: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "lag", "members"),
+			"Number of ports in LAG.", []string{"lag"}, nil),
+		lagMemberEnabled: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "lag", "member_egress_enabled"),
+			"Tells if LAG sends traffic through member port.", []string{"lag", "port"}, nil),
+		stpPortState: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "stp", "port_state"),
+			"STP state of port in the default STG.", []string{"port", "state"}, nil),
+	}
+
+	for _, name := range portCounterNames {
+		collector.portCounters = append(collector.portCounters, prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "port", name), "Port counter read from SDK.", []string{"port"}, nil))
+	}
+
+	return collector
+}
+
+func (collector *switchCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range collector.portCounters {
+		ch <- desc
+	}
+
+	ch <- collector.linkUp
+	ch <- collector.linkFlaps
+	ch <- collector.lagMembers
+	ch <- collector.lagMemberEnabled
+	ch <- collector.stpPortState
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+func (collector *switchCollector) collectPorts(ch chan<- prometheus.Metric) {
+	for _, portNameMap := range PortNames {
+		portName := portNameMap.PortName
+		if sample, err := collector.sw.stats.latest(portName); err == nil {
+			for counter, desc := range collector.portCounters {
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(sample.counters[counter]), portName)
+			}
+		} else {
+			log.Errorf("Failed to read counters of port %s: %s", portName, err)
+		}
+
+		link := collector.sw.links.link(portName)
+		ch <- prometheus.MustNewConstMetric(collector.linkUp, prometheus.GaugeValue, boolToFloat(link.up), portName)
+		ch <- prometheus.MustNewConstMetric(collector.linkFlaps, prometheus.CounterValue, float64(link.flaps), portName)
+	}
+}
+
+func (collector *switchCollector) collectLags(ch chan<- prometheus.Metric) {
+	collector.sw.ifaceMtx.RLock()
+	defer collector.sw.ifaceMtx.RUnlock()
+
+	for lagIfname, lag := range collector.sw.lagIfaces {
+		ch <- prometheus.MustNewConstMetric(collector.lagMembers, prometheus.GaugeValue, float64(len(lag.members)), lagIfname)
+		for portName := range lag.members {
+			_, egressDisabled := lag.egressDisabled[portName]
+			ch <- prometheus.MustNewConstMetric(collector.lagMemberEnabled, prometheus.GaugeValue, boolToFloat(!egressDisabled), lagIfname, portName)
+		}
+	}
+}
+
+func (collector *switchCollector) collectStp(ch chan<- prometheus.Metric) {
+	unit := collector.sw.asic.unit
+	stg, err := opennsl.StpDefaultGet(unit)
+	if err != nil {
+		sdkCall("StpDefaultGet", err)
+		log.Errorf("Failed to get default STG STP: %s", err)
+		return
+	}
+
+	for _, portNameMap := range PortNames {
+		state, err := stg.StpGet(unit, portNameMap.Port)
+		if err != nil {
+			sdkCall("StgStpGet", err)
+			log.Errorf("Failed to get STP state of port %s: %s", portNameMap.PortName, err)
+			continue
+		}
+
+		for stpState, name := range stgStpNames {
+			ch <- prometheus.MustNewConstMetric(collector.stpPortState, prometheus.GaugeValue,
+				boolToFloat(stpState == state), portNameMap.PortName, name)
+		}
+	}
+}
+
+func (collector *switchCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.collectPorts(ch)
+	collector.collectLags(ch)
+	collector.collectStp(ch)
+}
+
+// StartMetrics exports metrics of the switch and of the daemon at /metrics on given address.
+func (sw *Switch) StartMetrics(addr string) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		rpcRequests,
+		rpcDuration,
+		sdkErrors,
+		rxPackets,
+		newSwitchCollector(sw),
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	log.Infof("Exporting metrics at %s/metrics", addr)
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Errorf("Failed to serve metrics: %s", err)
+		}
+	}()
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net"
 	"strings"
+	"sync"
 
 	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
@@ -11,6 +12,12 @@ import (
 
 type mgmtIfaceKnetFiltersType map[string]int
 
+// mgmtKnetFilter describes KNET filter of management interface, so it can be created again.
+type mgmtKnetFilter struct {
+	rxReason opennsl.RxReason
+	prio     int
+}
+
 // MgmtIface represents settings of switch management interface.
 type MgmtIface struct {
 	asic           Asic
@@ -22,6 +29,9 @@ type MgmtIface struct {
 	l3EgressID     opennsl.L3EgressID
 	knetNetIfaceID int
 	knetFilters    mgmtIfaceKnetFiltersType
+	mtx            sync.Mutex
+	stripTag       bool
+	filterSpecs    map[string]mgmtKnetFilter
 }
 
 func NewMgmtIface(ifaceName string, vlan opennsl.Vlan, macAddr net.HardwareAddr, ipAddr net.IP) *MgmtIface {
@@ -32,6 +42,8 @@ func NewMgmtIface(ifaceName string, vlan opennsl.Vlan, macAddr net.HardwareAddr,
 		macAddr:     macAddr,
 		ipAddr:      ipAddr,
 		knetFilters: make(mgmtIfaceKnetFiltersType),
+		stripTag:    true,
+		filterSpecs: make(map[string]mgmtKnetFilter),
 	}
 }
 
@@ -50,7 +62,7 @@ func (mgmtIface *MgmtIface) SetIfaceName(ifaceName string) error {
 func (mgmtIface *MgmtIface) SetVlan(vlan uint16) error {
 	var vid opennsl.Vlan = opennsl.Vlan(vlan)
 	if !vid.Valid() {
-		log.Errorf("There is not valid VLAN ID %hu", vlan)
+		log.Errorf("There is not valid VLAN ID %d", vlan)
 		return errors.New("VLAN ID is not valid")
 	}
 
@@ -148,9 +160,7 @@ func (mgmt *MgmtIface) setupKnetFilter(rxReason opennsl.RxReason, prio int, desc
 	knetFilter := opennsl.NewKnetFilter()
 	knetFilter.SetDescription(desc)
 	knetFilter.SetType(opennsl.KNET_FILTER_T_RX_PKT)
-	knetFilter.SetFlags(opennsl.NewKnetFilterFlags(
-		opennsl.KNET_FILTER_F_STRIP_TAG,
-	))
+	knetFilter.SetFlags(knetFilterFlags(mgmt.stripTag))
 	knetFilter.SetMatchFlags(opennsl.NewKnetFilterMatchFlags(
 		opennsl.KNET_FILTER_M_REASON,
 	))
@@ -164,9 +174,53 @@ func (mgmt *MgmtIface) setupKnetFilter(rxReason opennsl.RxReason, prio int, desc
 	}
 
 	mgmt.knetFilters[desc] = knetFilter.ID()
+	mgmt.filterSpecs[desc] = mgmtKnetFilter{rxReason: rxReason, prio: prio}
+	return nil
+}
+
+func (mgmt *MgmtIface) recreateKnetFilters() error {
+	for desc, spec := range mgmt.filterSpecs {
+		if filterID, exists := mgmt.knetFilters[desc]; exists {
+			if err := opennsl.KnetFilterDestroy(mgmt.asic.unit, filterID); err != nil {
+				log.Errorf("Failed to destroy KNET filter %s: %s", desc, err)
+				return err
+			}
+
+			delete(mgmt.knetFilters, desc)
+		}
+
+		if err := mgmt.setupKnetFilter(spec.rxReason, spec.prio, desc); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// SetStripTag tells if VLAN tag is stripped from frames delivered to management interface.
+// Filters of created interface are created again and previous ones are restored on failure.
+func (mgmt *MgmtIface) SetStripTag(stripTag bool) error {
+	mgmt.mtx.Lock()
+	defer mgmt.mtx.Unlock()
+
+	if stripTag == mgmt.stripTag {
+		return nil
+	}
+
+	mgmt.stripTag = stripTag
+	err := mgmt.recreateKnetFilters()
+	if err == nil {
+		return nil
+	}
+
+	mgmt.stripTag = !stripTag
+	if restoreErr := mgmt.recreateKnetFilters(); restoreErr != nil {
+		log.Errorf("Failed to restore KNET filters of management interface %s: %s", mgmt.ifaceName, restoreErr)
+	}
+
+	return err
+}
+
 // Create creates instance of switch management interface.
 func (mgmtIface *MgmtIface) Create() error {
 	if err := mgmtIface.setupL3Iface(); err != nil {
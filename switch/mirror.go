@@ -0,0 +1,614 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchMirror/gRPCServices"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	mirrorMgmtPort = ":50058"
+	// Mirrored copies take precedence over KNET filters of ports
+	mirrorKnetFilterPrio = 0
+	mirrorKnetFilterDesc = "Mirrored packets"
+	mirrorNetdevMAC      = "02:00:00:00:00:01"
+	// Transparent Ethernet Bridging, mirrored frames follow GRE header as they are
+	MIRROR_GRE_PROTOCOL = 0x6558
+	DEFAULT_GRE_TTL     = 64
+)
+
+// MirrorDestType tells where mirrored traffic is sent.
+type MirrorDestType int
+
+const (
+	MIRROR_DEST_PORT MirrorDestType = iota
+	// Remote SPAN, mirrored traffic is sent tagged with RSPAN VLAN
+	MIRROR_DEST_RSPAN
+	// Mirrored traffic is sent in GRE tunnel. OpenNSL does not program ERSPAN header, so
+	// this is plain GRE encapsulation, not ERSPAN.
+	MIRROR_DEST_GRE
+	// Mirrored traffic is delivered to KNET netdev
+	MIRROR_DEST_CPU
+)
+
+var mirrorDestTypeNames = map[MirrorDestType]string{
+	MIRROR_DEST_PORT:  "port",
+	MIRROR_DEST_RSPAN: "RSPAN",
+	MIRROR_DEST_GRE:   "GRE",
+	MIRROR_DEST_CPU:   "CPU",
+}
+
+func (destType MirrorDestType) String() string {
+	return mirrorDestTypeNames[destType]
+}
+
+// MirrorSource represents port or LAG whose received or sent traffic is mirrored.
+type MirrorSource struct {
+	Ifname  string `json:"ifname"`
+	Ingress bool   `json:"ingress"`
+	Egress  bool   `json:"egress"`
+}
+
+// MirrorSession represents sources of mirrored traffic and its destination.
+type MirrorSession struct {
+	Sources    []MirrorSource `json:"sources"`
+	DestType   MirrorDestType `json:"destType"`
+	DestIfname string         `json:"destIfname,omitempty"`
+	Vlan       uint16         `json:"vlan,omitempty"`
+	SrcIP      string         `json:"srcIp,omitempty"`
+	DstIP      string         `json:"dstIp,omitempty"`
+	SrcMAC     string         `json:"srcMac,omitempty"`
+	DstMAC     string         `json:"dstMac,omitempty"`
+	Ttl        uint8          `json:"ttl,omitempty"`
+	Tos        uint8          `json:"tos,omitempty"`
+	Netdev     string         `json:"netdev,omitempty"`
+}
+
+func (session MirrorSession) usesIface(ifname string) bool {
+	for _, source := range session.Sources {
+		if source.Ifname == ifname {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (session MirrorSession) validate() error {
+	if len(session.Sources) == 0 {
+		return fmt.Errorf("Mirror session has no sources")
+	}
+
+	for _, source := range session.Sources {
+		if !source.Ingress && !source.Egress {
+			return fmt.Errorf("Neither ingress nor egress traffic of %s is mirrored", source.Ifname)
+		}
+
+		if source.Ifname == session.DestIfname {
+			return fmt.Errorf("Interface %s cannot be both source and destination of mirror session", source.Ifname)
+		}
+	}
+
+	if session.DestType == MIRROR_DEST_CPU {
+		if len(session.Netdev) == 0 {
+			return fmt.Errorf("Netdev receiving mirrored traffic is not given")
+		}
+
+		return nil
+	}
+
+	if _, err := portByName(session.DestIfname); err != nil {
+		return fmt.Errorf("Destination of mirror session has to be a port: %s", err)
+	}
+
+	switch session.DestType {
+	case MIRROR_DEST_PORT:
+	case MIRROR_DEST_RSPAN:
+		if err := validateVlan(opennsl.Vlan(session.Vlan)); err != nil {
+			return fmt.Errorf("Invalid RSPAN VLAN: %s", err)
+		}
+	case MIRROR_DEST_GRE:
+		if session.Vlan != 0 {
+			if err := validateVlan(opennsl.Vlan(session.Vlan)); err != nil {
+				return fmt.Errorf("Invalid VLAN of GRE tunnel: %s", err)
+			}
+		}
+
+		for _, ip := range []string{session.SrcIP, session.DstIP} {
+			if addr := net.ParseIP(ip); addr == nil || addr.To4() == nil {
+				return fmt.Errorf("Invalid IPv4 address %q of GRE tunnel", ip)
+			}
+		}
+
+		for _, mac := range []string{session.SrcMAC, session.DstMAC} {
+			if _, err := net.ParseMAC(mac); err != nil {
+				return fmt.Errorf("Invalid MAC address of GRE tunnel: %s", err)
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown destination type %d of mirror session", session.DestType)
+	}
+
+	return nil
+}
+
+// mirrorSessionState keeps SDK objects of programmed session.
+type mirrorSessionState struct {
+	destID         opennsl.GPort
+	ports          map[opennsl.Port]opennsl.MirrorPortFlags
+	knetNetIfaceID int
+	knetFilterID   int
+	knetCreated    bool
+}
+
+type mirrorManager struct {
+	sw       *Switch
+	mtx      sync.Mutex
+	sessions map[string]*mirrorSessionState
+}
+
+func newMirrorManager(sw *Switch) *mirrorManager {
+	return &mirrorManager{
+		sw:       sw,
+		sessions: make(map[string]*mirrorSessionState),
+	}
+}
+
+// resolveSources maps sources of session to physical ports. LAG sources are mirrored on
+// their current members. Sources which cannot be resolved are skipped unless strict, as
+// LAGs are created after sessions are restored.
+func (mirror *mirrorManager) resolveSources(session MirrorSession, strict bool) (map[opennsl.Port]opennsl.MirrorPortFlags, error) {
+	ports := make(map[opennsl.Port]opennsl.MirrorPortFlags)
+	for _, source := range session.Sources {
+		iface, err := mirror.sw.ResolveL2Iface(source.Ifname)
+		if err != nil {
+			if strict {
+				return nil, err
+			}
+
+			log.Warnf("Source %s of mirror session is not mirrored: %s", source.Ifname, err)
+			continue
+		}
+
+		for _, portName := range iface.Members {
+			port, err := portByName(portName)
+			if err != nil {
+				return nil, err
+			}
+
+			if portName == session.DestIfname {
+				return nil, fmt.Errorf("Port %s of %s is destination of mirror session", portName, source.Ifname)
+			}
+
+			if source.Ingress {
+				ports[port] |= opennsl.MIRROR_PORT_INGRESS
+			}
+
+			if source.Egress {
+				ports[port] |= opennsl.MIRROR_PORT_EGRESS
+			}
+		}
+	}
+
+	return ports, nil
+}
+
+func (mirror *mirrorManager) createDestination(session MirrorSession) (opennsl.GPort, error) {
+	dest := opennsl.NewMirrorDestination()
+	if session.DestType == MIRROR_DEST_CPU {
+		dest.SetGPort(opennsl.GPortFromLocal(CPU_PORT))
+	} else {
+		port, err := portByName(session.DestIfname)
+		if err != nil {
+			return 0, err
+		}
+
+		dest.SetGPort(opennsl.GPortFromLocal(port))
+	}
+
+	switch session.DestType {
+	case MIRROR_DEST_RSPAN:
+		dest.SetFlags(opennsl.MIRROR_DEST_TUNNEL_L2)
+		dest.SetVlan(opennsl.Vlan(session.Vlan))
+		dest.SetTpid(DEFAULT_TPID)
+	case MIRROR_DEST_GRE:
+		srcMAC, _ := net.ParseMAC(session.SrcMAC)
+		dstMAC, _ := net.ParseMAC(session.DstMAC)
+		ttl := session.Ttl
+		if ttl == 0 {
+			ttl = DEFAULT_GRE_TTL
+		}
+
+		dest.SetFlags(opennsl.MIRROR_DEST_TUNNEL_IP_GRE)
+		dest.SetVersion(4)
+		dest.SetSrcAddr(net.ParseIP(session.SrcIP).To4())
+		dest.SetDstAddr(net.ParseIP(session.DstIP).To4())
+		dest.SetSrcMAC(srcMAC)
+		dest.SetDstMAC(dstMAC)
+		dest.SetTtl(ttl)
+		dest.SetTos(session.Tos)
+		dest.SetGreProtocol(MIRROR_GRE_PROTOCOL)
+		if session.Vlan != 0 {
+			dest.SetVlan(opennsl.Vlan(session.Vlan))
+			dest.SetTpid(DEFAULT_TPID)
+		}
+	}
+
+	if err := sdkCall("MirrorDestinationCreate", dest.Create(mirror.sw.asic.unit)); err != nil {
+		return 0, err
+	}
+
+	return dest.ID(), nil
+}
+
+// setupKnet delivers traffic mirrored to CPU to the netdev of session. Tags are kept, so
+// the netdev shows frames as seen on source ports.
+func (mirror *mirrorManager) setupKnet(state *mirrorSessionState, netdev string) error {
+	macAddr, _ := net.ParseMAC(mirrorNetdevMAC)
+	knetNetIface := opennsl.NewKnetNetIface()
+	knetNetIface.SetType(opennsl.KNET_NETIF_T_TX_CPU_INGRESS)
+	knetNetIface.SetVlan(opennsl.VLAN_ID_DEFAULT)
+	knetNetIface.SetName(netdev)
+	knetNetIface.SetMAC(macAddr)
+	if err := knetNetIface.Create(mirror.sw.asic.unit); err != nil {
+		return fmt.Errorf("Failed to create KNET netdev %s: %s", netdev, err)
+	}
+
+	state.knetNetIfaceID = knetNetIface.ID()
+	state.knetCreated = true
+
+	knetFilter := opennsl.NewKnetFilter()
+	knetFilter.SetDescription(mirrorKnetFilterDesc)
+	knetFilter.SetType(opennsl.KNET_FILTER_T_RX_PKT)
+	knetFilter.SetFlags(knetFilterFlags(false))
+	knetFilter.SetMatchFlags(opennsl.NewKnetFilterMatchFlags(
+		opennsl.KNET_FILTER_M_REASON,
+	))
+	knetFilter.SetDestType(opennsl.KNET_DEST_T_NETIF)
+	knetFilter.SetDestID(state.knetNetIfaceID)
+	knetFilter.SetPriority(mirrorKnetFilterPrio)
+	knetFilter.SetRxReason(opennsl.RxReasonMirror)
+	if err := knetFilter.Create(mirror.sw.asic.unit); err != nil {
+		return fmt.Errorf("Failed to create KNET filter of mirrored packets: %s", err)
+	}
+
+	state.knetFilterID = knetFilter.ID()
+	return nil
+}
+
+// syncPorts mirrors given ports to destination of session and stops mirroring others.
+func (mirror *mirrorManager) syncPorts(state *mirrorSessionState, ports map[opennsl.Port]opennsl.MirrorPortFlags) error {
+	unit := mirror.sw.asic.unit
+	for port, flags := range state.ports {
+		if ports[port] == flags {
+			continue
+		}
+
+		if err := sdkCall("MirrorPortDestDelete", opennsl.MirrorPortDestDelete(unit, port, flags, state.destID)); err != nil {
+			return fmt.Errorf("Failed to stop mirroring port %d: %s", port, err)
+		}
+
+		delete(state.ports, port)
+	}
+
+	for port, flags := range ports {
+		if _, exists := state.ports[port]; exists {
+			continue
+		}
+
+		if err := sdkCall("MirrorPortDestAdd", opennsl.MirrorPortDestAdd(unit, port, flags, state.destID)); err != nil {
+			return fmt.Errorf("Failed to mirror port %d: %s", port, err)
+		}
+
+		state.ports[port] = flags
+	}
+
+	return nil
+}
+
+// teardown removes SDK objects of session, also of partially programmed one.
+func (mirror *mirrorManager) teardown(state *mirrorSessionState) error {
+	unit := mirror.sw.asic.unit
+	if err := mirror.syncPorts(state, nil); err != nil {
+		return err
+	}
+
+	if state.destID != 0 {
+		if err := sdkCall("MirrorDestinationDestroy", opennsl.MirrorDestinationDestroy(unit, state.destID)); err != nil {
+			return fmt.Errorf("Failed to destroy mirror destination: %s", err)
+		}
+
+		state.destID = 0
+	}
+
+	if state.knetFilterID != 0 {
+		if err := opennsl.KnetFilterDestroy(unit, state.knetFilterID); err != nil {
+			return fmt.Errorf("Failed to destroy KNET filter of mirrored packets: %s", err)
+		}
+
+		state.knetFilterID = 0
+	}
+
+	if state.knetCreated {
+		if err := opennsl.KnetNetIfaceDestroy(unit, state.knetNetIfaceID); err != nil {
+			return fmt.Errorf("Failed to destroy KNET netdev of mirrored packets: %s", err)
+		}
+
+		state.knetCreated = false
+	}
+
+	return nil
+}
+
+func (mirror *mirrorManager) program(session MirrorSession, strict bool) (*mirrorSessionState, error) {
+	ports, err := mirror.resolveSources(session, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &mirrorSessionState{ports: make(map[opennsl.Port]opennsl.MirrorPortFlags)}
+	err = func() error {
+		if session.DestType == MIRROR_DEST_CPU {
+			if err := mirror.setupKnet(state, session.Netdev); err != nil {
+				return err
+			}
+		}
+
+		if state.destID, err = mirror.createDestination(session); err != nil {
+			return fmt.Errorf("Failed to create mirror destination: %s", err)
+		}
+
+		return mirror.syncPorts(state, ports)
+	}()
+
+	if err != nil {
+		if teardownErr := mirror.teardown(state); teardownErr != nil {
+			log.Errorf("Failed to clean up mirror session: %s", teardownErr)
+		}
+
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Set creates mirror session or replaces existing session of the same name.
+func (mirror *mirrorManager) Set(name string, session MirrorSession) error {
+	if len(name) == 0 {
+		return fmt.Errorf("Name of mirror session is empty")
+	}
+
+	if err := session.validate(); err != nil {
+		return err
+	}
+
+	mirror.mtx.Lock()
+	defer mirror.mtx.Unlock()
+
+	if session.DestType == MIRROR_DEST_CPU {
+		// Copies mirrored to CPU cannot be told apart by session
+		for otherName, other := range mirror.Sessions() {
+			if otherName != name && other.DestType == MIRROR_DEST_CPU {
+				return fmt.Errorf("Session %s already mirrors traffic to CPU", otherName)
+			}
+		}
+	}
+
+	// Old session is torn down first, as both sessions may need the same source ports or
+	// KNET netdev. It is programmed again if the new one cannot be.
+	prevSession, replaced := mirror.Sessions()[name]
+	if state, exists := mirror.sessions[name]; exists {
+		if err := mirror.teardown(state); err != nil {
+			return err
+		}
+
+		delete(mirror.sessions, name)
+	}
+
+	state, err := mirror.program(session, true)
+	if err != nil {
+		if replaced {
+			prevState, restoreErr := mirror.program(prevSession, false)
+			if restoreErr != nil {
+				log.Errorf("Failed to restore mirror session %s: %s", name, restoreErr)
+			} else {
+				mirror.sessions[name] = prevState
+			}
+		}
+
+		return err
+	}
+
+	mirror.sessions[name] = state
+	return mirror.sw.cfg.update(func(c *Config) {
+		c.MirrorSessions[name] = session
+	})
+}
+
+func (mirror *mirrorManager) Delete(name string) error {
+	mirror.mtx.Lock()
+	defer mirror.mtx.Unlock()
+
+	state, exists := mirror.sessions[name]
+	if !exists {
+		return fmt.Errorf("Mirror session %s does not exist", name)
+	}
+
+	if err := mirror.teardown(state); err != nil {
+		return err
+	}
+
+	delete(mirror.sessions, name)
+	return mirror.sw.cfg.update(func(c *Config) {
+		delete(c.MirrorSessions, name)
+	})
+}
+
+func (mirror *mirrorManager) Sessions() map[string]MirrorSession {
+	sessions := make(map[string]MirrorSession)
+	mirror.sw.cfg.view(func(c *Config) {
+		for name, session := range c.MirrorSessions {
+			sessions[name] = session
+		}
+	})
+
+	return sessions
+}
+
+func (mirror *mirrorManager) restore() error {
+	mirror.mtx.Lock()
+	defer mirror.mtx.Unlock()
+
+	for name, session := range mirror.Sessions() {
+		log.Infof("Restoring mirror session %s (%s)", name, session.DestType)
+		state, err := mirror.program(session, false)
+		if err != nil {
+			log.Errorf("Failed to restore mirror session %s: %s", name, err)
+			continue
+		}
+
+		mirror.sessions[name] = state
+	}
+
+	return nil
+}
+
+// lagChanged updates sessions mirroring LAG after its members changed. It is called with
+// interface registry locked, so sessions are updated in the background.
+func (mirror *mirrorManager) lagChanged(lagIfname string) {
+	go func() {
+		mirror.mtx.Lock()
+		defer mirror.mtx.Unlock()
+
+		for name, session := range mirror.Sessions() {
+			state, exists := mirror.sessions[name]
+			if !exists || !session.usesIface(lagIfname) {
+				continue
+			}
+
+			ports, err := mirror.resolveSources(session, false)
+			if err == nil {
+				err = mirror.syncPorts(state, ports)
+			}
+
+			if err != nil {
+				log.Errorf("Failed to update mirror session %s after members of LAG %s changed: %s", name, lagIfname, err)
+			}
+		}
+	}()
+}
+
+func fromPbMirrorSession(req *pb.MirrorSession) MirrorSession {
+	session := MirrorSession{
+		DestType:   MirrorDestType(req.GetDestType()),
+		DestIfname: req.GetDestIfname(),
+		Vlan:       uint16(req.GetVlan()),
+		SrcIP:      req.GetSrcIp(),
+		DstIP:      req.GetDstIp(),
+		SrcMAC:     req.GetSrcMac(),
+		DstMAC:     req.GetDstMac(),
+		Ttl:        uint8(req.GetTtl()),
+		Tos:        uint8(req.GetTos()),
+		Netdev:     req.GetNetdev(),
+	}
+
+	for _, source := range req.GetSources() {
+		direction := source.GetDirection()
+		session.Sources = append(session.Sources, MirrorSource{
+			Ifname:  source.GetIfname(),
+			Ingress: direction != pb.MirrorSource_EGRESS,
+			Egress:  direction != pb.MirrorSource_INGRESS,
+		})
+	}
+
+	return session
+}
+
+func toPbMirrorSession(name string, session MirrorSession) *pb.MirrorSession {
+	result := &pb.MirrorSession{
+		Name:       name,
+		DestType:   pb.MirrorSession_DestType(session.DestType),
+		DestIfname: session.DestIfname,
+		Vlan:       uint32(session.Vlan),
+		SrcIp:      session.SrcIP,
+		DstIp:      session.DstIP,
+		SrcMac:     session.SrcMAC,
+		DstMac:     session.DstMAC,
+		Ttl:        uint32(session.Ttl),
+		Tos:        uint32(session.Tos),
+		Netdev:     session.Netdev,
+	}
+
+	for _, source := range session.Sources {
+		direction := pb.MirrorSource_BOTH
+		if !source.Egress {
+			direction = pb.MirrorSource_INGRESS
+		} else if !source.Ingress {
+			direction = pb.MirrorSource_EGRESS
+		}
+
+		result.Sources = append(result.Sources, &pb.MirrorSource{Ifname: source.Ifname, Direction: direction})
+	}
+
+	return result
+}
+
+type mirrorRequestMgmt struct {
+	pb.UnimplementedMirrorManagementServer
+	sw *Switch
+}
+
+func (mirrorMgmt *mirrorRequestMgmt) SetMirrorSession(ctx context.Context, req *pb.MirrorSession) (*pb.MirrorResult, error) {
+	log.Infof("SetMirrorSession: Name %s, %d sources, destination %s %s",
+		req.GetName(), len(req.GetSources()), MirrorDestType(req.GetDestType()), req.GetDestIfname())
+	if err := mirrorMgmt.sw.mirror.Set(req.GetName(), fromPbMirrorSession(req)); err != nil {
+		log.Errorf("Failed to set mirror session %s: %s", req.GetName(), err)
+		return &pb.MirrorResult{Result: pb.MirrorResult_FAILED}, err
+	}
+
+	return &pb.MirrorResult{Result: pb.MirrorResult_SUCCESS}, nil
+}
+
+func (mirrorMgmt *mirrorRequestMgmt) DeleteMirrorSession(ctx context.Context, req *pb.MirrorSessionName) (*pb.MirrorResult, error) {
+	log.Infof("DeleteMirrorSession: Name %s", req.GetName())
+	if err := mirrorMgmt.sw.mirror.Delete(req.GetName()); err != nil {
+		log.Errorf("Failed to delete mirror session %s: %s", req.GetName(), err)
+		return &pb.MirrorResult{Result: pb.MirrorResult_FAILED}, err
+	}
+
+	return &pb.MirrorResult{Result: pb.MirrorResult_SUCCESS}, nil
+}
+
+func (mirrorMgmt *mirrorRequestMgmt) ListMirrorSessions(ctx context.Context, req *pb.MirrorEmpty) (*pb.MirrorSessionList, error) {
+	sessions := mirrorMgmt.sw.mirror.Sessions()
+	names := make([]string, 0, len(sessions))
+	for name := range sessions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	result := &pb.MirrorSessionList{}
+	for _, name := range names {
+		result.Sessions = append(result.Sessions, toPbMirrorSession(name, sessions[name]))
+	}
+
+	return result, nil
+}
+
+func HandleMirrorRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", mirrorMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterMirrorManagementServer(s, &mirrorRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package bcm
+
+import (
+	"net"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	NETDEV_SYNC_INTERVAL = 30 * time.Second
+)
+
+// netdevSync applies admin state and MTU of KNET port netdevs to ASIC ports, so that
+// `ip link set` on the netdev configures the port.
+type netdevSync struct {
+	sw      *Switch
+	trigger netlinkTrigger
+}
+
+func newNetdevSync(sw *Switch) *netdevSync {
+	return &netdevSync{
+		sw:      sw,
+		trigger: newNetlinkTrigger(),
+	}
+}
+
+func (ns *netdevSync) syncPort(portName string) error {
+	link, err := netlink.LinkByName(portName)
+	if err != nil {
+		return err
+	}
+
+	adminUp := link.Attrs().Flags&net.FlagUp != 0
+	mtu := uint32(link.Attrs().MTU)
+	settings := ns.sw.ports.settings(portName)
+	if settings.AdminDown == adminUp {
+		if adminUp {
+			log.Infof("Netdev %s is up, enabling port", portName)
+		} else {
+			log.Infof("Netdev %s is down, shutting down port", portName)
+		}
+
+		if err := ns.sw.ports.SetAdminState(portName, adminUp); err != nil {
+			return err
+		}
+	}
+
+	if settings.Mtu == mtu {
+		return nil
+	}
+
+	// MTU which has never been set is compared against the one used by hardware, so that
+	// unchanged netdevs do not get their MTU persisted
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	hwMtu, err := ns.sw.ports.readMtu(port)
+	if err != nil {
+		return err
+	}
+
+	if hwMtu != mtu {
+		log.Infof("MTU of netdev %s changed to %d", portName, mtu)
+		if err := ns.sw.ports.SetMtu(portName, mtu); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ns *netdevSync) sync() {
+	for _, portNameMap := range PortNames {
+		if err := ns.syncPort(portNameMap.PortName); err != nil {
+			log.Errorf("Failed to sync netdev %s to port: %s", portNameMap.PortName, err)
+		}
+	}
+}
+
+func (ns *netdevSync) run() {
+	ns.trigger.run(NETDEV_SYNC_INTERVAL, ns.sync)
+}
+
+// StartNetdevSync starts applying admin state and MTU of port netdevs to ASIC ports. Settings
+// of netdevs take precedence over settings made over gRPC.
+func (sw *Switch) StartNetdevSync() {
+	log.Infof("Starting sync of port netdevs admin state and MTU into ports")
+	ns := newNetdevSync(sw)
+	go watchLinkChanges(ns.trigger.notify)
+	go ns.run()
+}
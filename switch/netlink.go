@@ -0,0 +1,71 @@
+package bcm
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Notifications usually come in bursts, e.g. one per VLAN, so they are handled together
+	netlinkSyncDelay = 200 * time.Millisecond
+)
+
+// netlinkTrigger coalesces kernel notifications, so that state mirrored from kernel is
+// read once per burst of them.
+type netlinkTrigger chan struct{}
+
+func newNetlinkTrigger() netlinkTrigger {
+	return make(netlinkTrigger, 1)
+}
+
+func (trigger netlinkTrigger) notify() {
+	select {
+	case trigger <- struct{}{}:
+	default:
+	}
+}
+
+// run calls sync at start, after notifications and periodically, in case any notification is lost.
+func (trigger netlinkTrigger) run(interval time.Duration, sync func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sync()
+		select {
+		case <-ticker.C:
+		case <-trigger:
+			time.Sleep(netlinkSyncDelay)
+			select {
+			case <-trigger:
+			default:
+			}
+		}
+	}
+}
+
+// watchLinkChanges calls notify on every link notification. Messages are not parsed, so
+// notifications of any address family, e.g. AF_BRIDGE port changes, are seen.
+func watchLinkChanges(notify func()) {
+	sock, err := nl.Subscribe(unix.NETLINK_ROUTE, unix.RTNLGRP_LINK)
+	if err != nil {
+		log.Errorf("Failed to subscribe for link notifications: %s", err)
+		return
+	}
+
+	defer sock.Close()
+	for {
+		msgs, _, err := sock.Receive()
+		if err != nil {
+			log.Errorf("Failed to receive link notification: %s", err)
+			return
+		}
+
+		if len(msgs) > 0 {
+			notify()
+		}
+	}
+}
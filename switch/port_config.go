@@ -0,0 +1,720 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPort/gRPCServices"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	portMgmtPort = ":50057"
+	// Ethernet header, FCS and two VLAN tags are not included in MTU
+	frameOverhead  = 14 + 4 + 8
+	MAX_FRAME_SIZE = 9216
+	MIN_MTU        = 68
+	MAX_MTU        = MAX_FRAME_SIZE - frameOverhead
+)
+
+// PortFecMode represents forward error correction mode of port.
+type PortFecMode uint32
+
+const (
+	PORT_FEC_OFF PortFecMode = iota
+	PORT_FEC_ON
+	PORT_FEC_AUTO
+)
+
+var portFecModes = map[PortFecMode]uint32{
+	PORT_FEC_OFF:  opennsl.PORT_PHY_CONTROL_FEC_OFF,
+	PORT_FEC_ON:   opennsl.PORT_PHY_CONTROL_FEC_ON,
+	PORT_FEC_AUTO: opennsl.PORT_PHY_CONTROL_FEC_AUTO,
+}
+
+// Speeds in Mb/s and their abilities
+var portSpeedAbilities = map[uint32]opennsl.PortAbilitySpeed{
+	10:     opennsl.PORT_SPEED_ABILITY_10MB,
+	100:    opennsl.PORT_SPEED_ABILITY_100MB,
+	1000:   opennsl.PORT_SPEED_ABILITY_1000MB,
+	2500:   opennsl.PORT_SPEED_ABILITY_2500MB,
+	5000:   opennsl.PORT_SPEED_ABILITY_5000MB,
+	10000:  opennsl.PORT_SPEED_ABILITY_10GB,
+	20000:  opennsl.PORT_SPEED_ABILITY_20GB,
+	25000:  opennsl.PORT_SPEED_ABILITY_25GB,
+	40000:  opennsl.PORT_SPEED_ABILITY_40GB,
+	50000:  opennsl.PORT_SPEED_ABILITY_50GB,
+	100000: opennsl.PORT_SPEED_ABILITY_100GB,
+}
+
+func speedsOf(abilities opennsl.PortAbilitySpeed) []uint32 {
+	speeds := make([]uint32, 0)
+	for speed, ability := range portSpeedAbilities {
+		if abilities&ability != 0 {
+			speeds = append(speeds, speed)
+		}
+	}
+
+	sort.Slice(speeds, func(i, j int) bool {
+		return speeds[i] < speeds[j]
+	})
+
+	return speeds
+}
+
+// PortAutoneg represents autonegotiation settings. Empty list of speeds advertises all speeds
+// supported by port.
+type PortAutoneg struct {
+	Enabled          bool     `json:"enabled"`
+	AdvertisedSpeeds []uint32 `json:"advertisedSpeeds,omitempty"`
+	AdvertisePause   bool     `json:"advertisePause,omitempty"`
+}
+
+// PortFlowControl represents sending and honoring of pause frames.
+type PortFlowControl struct {
+	Tx bool `json:"tx"`
+	Rx bool `json:"rx"`
+}
+
+// PortSettings represents settings of front panel port. Only settings which have been set
+// are applied, others are left as configured by SDK.
+type PortSettings struct {
+	AdminDown   bool             `json:"adminDown,omitempty"`
+	Speed       uint32           `json:"speed,omitempty"`
+	HalfDuplex  bool             `json:"halfDuplex,omitempty"`
+	Fec         *PortFecMode     `json:"fec,omitempty"`
+	Autoneg     *PortAutoneg     `json:"autoneg,omitempty"`
+	Mtu         uint32           `json:"mtu,omitempty"`
+	FlowControl *PortFlowControl `json:"flowControl,omitempty"`
+}
+
+// portManager validates port settings against port capabilities, applies and persists them.
+type portManager struct {
+	sw  *Switch
+	mtx sync.Mutex
+}
+
+func newPortManager(sw *Switch) *portManager {
+	return &portManager{sw: sw}
+}
+
+func (ports *portManager) settings(portName string) PortSettings {
+	var settings PortSettings
+	ports.sw.cfg.view(func(c *Config) {
+		settings = c.Ports[portName]
+	})
+
+	return settings
+}
+
+func (ports *portManager) save(portName string, change func(*PortSettings)) error {
+	return ports.sw.cfg.update(func(c *Config) {
+		settings := c.Ports[portName]
+		change(&settings)
+		c.Ports[portName] = settings
+	})
+}
+
+// portAdminDown tells if port has been shut down by user.
+func (sw *Switch) portAdminDown(portName string) bool {
+	adminDown := false
+	sw.cfg.view(func(c *Config) {
+		adminDown = c.Ports[portName].AdminDown
+	})
+
+	return adminDown
+}
+
+// applyAdminState enables port unless it is shut down by user or error-disabled.
+func (ports *portManager) applyAdminState(portName string, port opennsl.Port, adminDown bool) error {
+	ports.sw.errDisableMtx.Lock()
+	defer ports.sw.errDisableMtx.Unlock()
+
+	if _, errDisabled := ports.sw.errDisabled[portName]; errDisabled {
+		return nil
+	}
+
+	enable := opennsl.TRUE
+	if adminDown {
+		enable = opennsl.FALSE
+	}
+
+	return sdkCall("PortEnableSet", opennsl.PortEnableSet(ports.sw.asic.unit, port, enable))
+}
+
+func (ports *portManager) validateSpeed(port opennsl.Port, speed uint32, halfDuplex bool) error {
+	ability, exists := portSpeedAbilities[speed]
+	if !exists {
+		return fmt.Errorf("Unknown speed %d Mb/s", speed)
+	}
+
+	local, err := opennsl.PortAbilityLocalGet(ports.sw.asic.unit, port)
+	if err != nil {
+		return err
+	}
+
+	supported := local.SpeedFullDuplex()
+	if halfDuplex {
+		supported = local.SpeedHalfDuplex()
+	}
+
+	if supported&ability == 0 {
+		duplex := "full"
+		if halfDuplex {
+			duplex = "half"
+		}
+
+		return fmt.Errorf("Speed %d Mb/s in %s duplex is not supported, supported speeds: %v", speed, duplex, speedsOf(supported))
+	}
+
+	return nil
+}
+
+func (ports *portManager) applySpeed(port opennsl.Port, speed uint32, halfDuplex bool) error {
+	if err := opennsl.PortAutonegSet(ports.sw.asic.unit, port, opennsl.FALSE); err != nil {
+		return err
+	}
+
+	if err := opennsl.PortSpeedSet(ports.sw.asic.unit, port, int(speed)); err != nil {
+		return err
+	}
+
+	duplex := opennsl.PORT_DUPLEX_FULL
+	if halfDuplex {
+		duplex = opennsl.PORT_DUPLEX_HALF
+	}
+
+	return opennsl.PortDuplexSet(ports.sw.asic.unit, port, duplex)
+}
+
+func (ports *portManager) applyFec(port opennsl.Port, mode PortFecMode) error {
+	return opennsl.PortPhyControlSet(ports.sw.asic.unit, port, opennsl.PORT_PHY_CONTROL_FORWARD_ERROR_CORRECTION, portFecModes[mode])
+}
+
+// advertisement builds abilities advertised by autonegotiation, which have to be subset of
+// abilities of port.
+func (ports *portManager) advertisement(port opennsl.Port, autoneg *PortAutoneg) (*opennsl.PortAbility, error) {
+	local, err := opennsl.PortAbilityLocalGet(ports.sw.asic.unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	advert := opennsl.NewPortAbility()
+	if len(autoneg.AdvertisedSpeeds) == 0 {
+		advert.SetSpeedFullDuplex(local.SpeedFullDuplex())
+	} else {
+		var speeds opennsl.PortAbilitySpeed
+		for _, speed := range autoneg.AdvertisedSpeeds {
+			ability, exists := portSpeedAbilities[speed]
+			if !exists || local.SpeedFullDuplex()&ability == 0 {
+				return nil, fmt.Errorf("Speed %d Mb/s is not supported, supported speeds: %v", speed, speedsOf(local.SpeedFullDuplex()))
+			}
+
+			speeds |= ability
+		}
+
+		advert.SetSpeedFullDuplex(speeds)
+	}
+
+	if autoneg.AdvertisePause {
+		pause := local.Pause() & (opennsl.PORT_ABILITY_PAUSE_TX | opennsl.PORT_ABILITY_PAUSE_RX)
+		if pause == 0 {
+			return nil, fmt.Errorf("Pause is not supported")
+		}
+
+		advert.SetPause(pause)
+	}
+
+	return advert, nil
+}
+
+func (ports *portManager) applyAutoneg(port opennsl.Port, autoneg *PortAutoneg) error {
+	// Advertised abilities are validated even if autonegotiation is disabled, as they are
+	// preserved and used once it is enabled again.
+	advert, err := ports.advertisement(port, autoneg)
+	if err != nil {
+		return err
+	}
+
+	if !autoneg.Enabled {
+		return opennsl.PortAutonegSet(ports.sw.asic.unit, port, opennsl.FALSE)
+	}
+
+	if err := opennsl.PortAbilityAdvertSet(ports.sw.asic.unit, port, advert); err != nil {
+		return err
+	}
+
+	return opennsl.PortAutonegSet(ports.sw.asic.unit, port, opennsl.TRUE)
+}
+
+func validateMtu(mtu uint32) error {
+	if mtu < MIN_MTU || mtu > MAX_MTU {
+		return fmt.Errorf("MTU %d is out of range %d-%d", mtu, MIN_MTU, MAX_MTU)
+	}
+
+	return nil
+}
+
+func (ports *portManager) applyMtu(port opennsl.Port, mtu uint32) error {
+	return opennsl.PortFrameMaxSet(ports.sw.asic.unit, port, int(mtu+frameOverhead))
+}
+
+// readMtu returns MTU the port currently uses, derived from its maximum frame size.
+func (ports *portManager) readMtu(port opennsl.Port) (uint32, error) {
+	frameMax, err := opennsl.PortFrameMaxGet(ports.sw.asic.unit, port)
+	if err != nil || frameMax <= frameOverhead {
+		return 0, err
+	}
+
+	return uint32(frameMax - frameOverhead), nil
+}
+
+func (ports *portManager) applyFlowControl(port opennsl.Port, flowControl *PortFlowControl) error {
+	txPause, rxPause := opennsl.FALSE, opennsl.FALSE
+	if flowControl.Tx {
+		txPause = opennsl.TRUE
+	}
+
+	if flowControl.Rx {
+		rxPause = opennsl.TRUE
+	}
+
+	return opennsl.PortPauseSet(ports.sw.asic.unit, port, txPause, rxPause)
+}
+
+func (ports *portManager) apply(portName string, settings PortSettings) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	if settings.Autoneg != nil {
+		if err := ports.applyAutoneg(port, settings.Autoneg); err != nil {
+			return fmt.Errorf("Failed to set autonegotiation of port %s: %s", portName, err)
+		}
+	}
+
+	if settings.Speed != 0 && (settings.Autoneg == nil || !settings.Autoneg.Enabled) {
+		if err := ports.applySpeed(port, settings.Speed, settings.HalfDuplex); err != nil {
+			return fmt.Errorf("Failed to set speed of port %s: %s", portName, err)
+		}
+	}
+
+	if settings.Fec != nil {
+		if err := ports.applyFec(port, *settings.Fec); err != nil {
+			return fmt.Errorf("Failed to set FEC of port %s: %s", portName, err)
+		}
+	}
+
+	if settings.Mtu != 0 {
+		if err := ports.applyMtu(port, settings.Mtu); err != nil {
+			return fmt.Errorf("Failed to set MTU of port %s: %s", portName, err)
+		}
+	}
+
+	if settings.FlowControl != nil {
+		if err := ports.applyFlowControl(port, settings.FlowControl); err != nil {
+			return fmt.Errorf("Failed to set flow control of port %s: %s", portName, err)
+		}
+	}
+
+	if err := ports.applyAdminState(portName, port, settings.AdminDown); err != nil {
+		return fmt.Errorf("Failed to set admin state of port %s: %s", portName, err)
+	}
+
+	return nil
+}
+
+func (ports *portManager) restore() error {
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	configs := make(map[string]PortSettings)
+	ports.sw.cfg.view(func(c *Config) {
+		for portName, settings := range c.Ports {
+			configs[portName] = settings
+		}
+	})
+
+	// Each port is restored on its own, so a bad setting of one port does not leave others
+	// unrestored
+	portNames := make([]string, 0, len(configs))
+	for portName := range configs {
+		portNames = append(portNames, portName)
+	}
+
+	sort.Strings(portNames)
+	var failed []string
+	for _, portName := range portNames {
+		if err := ports.apply(portName, configs[portName]); err != nil {
+			log.Errorf("Failed to restore settings of port %s: %s", portName, err)
+			failed = append(failed, portName)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("settings of ports %s have not been restored", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// SetAdminState enables or shuts down port. Error-disabled port stays down until it is recovered.
+func (ports *portManager) SetAdminState(portName string, enabled bool) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	if err := ports.applyAdminState(portName, port, !enabled); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.AdminDown = !enabled
+	})
+}
+
+// SetSpeed forces speed and duplex of port, which disables autonegotiation.
+func (ports *portManager) SetSpeed(portName string, speed uint32, halfDuplex bool) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	if err := ports.validateSpeed(port, speed, halfDuplex); err != nil {
+		return err
+	}
+
+	if err := ports.applySpeed(port, speed, halfDuplex); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.Speed = speed
+		settings.HalfDuplex = halfDuplex
+		settings.Autoneg = &PortAutoneg{Enabled: false}
+	})
+}
+
+func (ports *portManager) SetFec(portName string, mode PortFecMode) error {
+	if _, exists := portFecModes[mode]; !exists {
+		return fmt.Errorf("Unknown FEC mode %d", mode)
+	}
+
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	if err := ports.applyFec(port, mode); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.Fec = &mode
+	})
+}
+
+// SetAutoneg enables autonegotiation with given advertisement, or disables it. Speed forced
+// before is forgotten when autonegotiation is enabled.
+func (ports *portManager) SetAutoneg(portName string, autoneg PortAutoneg) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	if err := ports.applyAutoneg(port, &autoneg); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.Autoneg = &autoneg
+		if autoneg.Enabled {
+			settings.Speed = 0
+			settings.HalfDuplex = false
+		}
+	})
+}
+
+func (ports *portManager) SetMtu(portName string, mtu uint32) error {
+	if err := validateMtu(mtu); err != nil {
+		return err
+	}
+
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	if err := ports.applyMtu(port, mtu); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.Mtu = mtu
+	})
+}
+
+func (ports *portManager) SetFlowControl(portName string, flowControl PortFlowControl) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	ports.mtx.Lock()
+	defer ports.mtx.Unlock()
+
+	local, err := opennsl.PortAbilityLocalGet(ports.sw.asic.unit, port)
+	if err != nil {
+		return err
+	}
+
+	if flowControl.Tx && local.Pause()&opennsl.PORT_ABILITY_PAUSE_TX == 0 {
+		return fmt.Errorf("Sending pause frames is not supported by port %s", portName)
+	}
+
+	if flowControl.Rx && local.Pause()&opennsl.PORT_ABILITY_PAUSE_RX == 0 {
+		return fmt.Errorf("Receiving pause frames is not supported by port %s", portName)
+	}
+
+	if err := ports.applyFlowControl(port, &flowControl); err != nil {
+		return err
+	}
+
+	return ports.save(portName, func(settings *PortSettings) {
+		settings.FlowControl = &flowControl
+	})
+}
+
+// readSpeed reads speed and duplex used by port.
+func (ports *portManager) readSpeed(portName string, port opennsl.Port) (*pb.PortSpeed, error) {
+	speed, err := opennsl.PortSpeedGet(ports.sw.asic.unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	duplex, err := opennsl.PortDuplexGet(ports.sw.asic.unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.PortSpeed{Ifname: portName, Speed: uint32(speed), Duplex: pb.PortSpeed_FULL}
+	if duplex == opennsl.PORT_DUPLEX_HALF {
+		result.Duplex = pb.PortSpeed_HALF
+	}
+
+	return result, nil
+}
+
+func (ports *portManager) readFec(portName string, port opennsl.Port) (*pb.PortFec, error) {
+	fec, err := opennsl.PortPhyControlGet(ports.sw.asic.unit, port, opennsl.PORT_PHY_CONTROL_FORWARD_ERROR_CORRECTION)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.PortFec{Ifname: portName}
+	for mode, value := range portFecModes {
+		if value == fec {
+			result.Mode = pb.PortFec_Mode(mode)
+		}
+	}
+
+	return result, nil
+}
+
+// PortConfig reads configuration of port from hardware.
+func (ports *portManager) PortConfig(portName string) (*pb.PortConfig, error) {
+	port, err := portByName(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	unit := ports.sw.asic.unit
+	result := &pb.PortConfig{
+		Ifname:      portName,
+		Enabled:     !ports.sw.portAdminDown(portName),
+		ErrDisabled: ports.sw.IsErrDisabled(portName),
+	}
+
+	if result.Speed, err = ports.readSpeed(portName, port); err != nil {
+		return nil, err
+	}
+
+	if result.Fec, err = ports.readFec(portName, port); err != nil {
+		return nil, err
+	}
+
+	autoneg, err := opennsl.PortAutonegGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	advert, err := opennsl.PortAbilityAdvertGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Autoneg = &pb.PortAutoneg{
+		Ifname:           portName,
+		Enabled:          autoneg == opennsl.TRUE,
+		AdvertisedSpeeds: speedsOf(advert.SpeedFullDuplex()),
+		AdvertisePause:   advert.Pause() != 0,
+	}
+
+	mtu, err := ports.readMtu(port)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Mtu = &pb.PortMtu{Ifname: portName, Mtu: mtu}
+
+	txPause, rxPause, err := opennsl.PortPauseGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	result.FlowControl = &pb.PortFlowControl{Ifname: portName, Tx: txPause == opennsl.TRUE, Rx: rxPause == opennsl.TRUE}
+	local, err := opennsl.PortAbilityLocalGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SupportedSpeeds = speedsOf(local.SpeedFullDuplex())
+	return result, nil
+}
+
+// requestedPorts returns given port or all front panel ports if name is empty.
+func requestedPorts(portName string) []string {
+	if len(portName) > 0 {
+		return []string{portName}
+	}
+
+	portNames := make([]string, 0, NUM_OF_PORTS)
+	for _, portNameMap := range PortNames {
+		portNames = append(portNames, portNameMap.PortName)
+	}
+
+	return portNames
+}
+
+type portRequestMgmt struct {
+	pb.UnimplementedPortManagementServer
+	sw *Switch
+}
+
+func (portMgmt *portRequestMgmt) SetAdminState(ctx context.Context, req *pb.PortAdminState) (*pb.PortResult, error) {
+	log.Infof("SetAdminState: Ifname %s, enabled %t", req.GetIfname(), req.GetEnabled())
+	if err := portMgmt.sw.ports.SetAdminState(req.GetIfname(), req.GetEnabled()); err != nil {
+		log.Errorf("Failed to set admin state of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) SetSpeed(ctx context.Context, req *pb.PortSpeed) (*pb.PortResult, error) {
+	log.Infof("SetSpeed: Ifname %s, speed %d Mb/s, %s duplex", req.GetIfname(), req.GetSpeed(), req.GetDuplex())
+	halfDuplex := req.GetDuplex() == pb.PortSpeed_HALF
+	if err := portMgmt.sw.ports.SetSpeed(req.GetIfname(), req.GetSpeed(), halfDuplex); err != nil {
+		log.Errorf("Failed to set speed of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) SetFec(ctx context.Context, req *pb.PortFec) (*pb.PortResult, error) {
+	log.Infof("SetFec: Ifname %s, mode %s", req.GetIfname(), req.GetMode())
+	if err := portMgmt.sw.ports.SetFec(req.GetIfname(), PortFecMode(req.GetMode())); err != nil {
+		log.Errorf("Failed to set FEC of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) SetAutoneg(ctx context.Context, req *pb.PortAutoneg) (*pb.PortResult, error) {
+	log.Infof("SetAutoneg: Ifname %s, enabled %t, speeds %v, pause %t",
+		req.GetIfname(), req.GetEnabled(), req.GetAdvertisedSpeeds(), req.GetAdvertisePause())
+	autoneg := PortAutoneg{
+		Enabled:          req.GetEnabled(),
+		AdvertisedSpeeds: req.GetAdvertisedSpeeds(),
+		AdvertisePause:   req.GetAdvertisePause(),
+	}
+
+	if err := portMgmt.sw.ports.SetAutoneg(req.GetIfname(), autoneg); err != nil {
+		log.Errorf("Failed to set autonegotiation of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) SetMtu(ctx context.Context, req *pb.PortMtu) (*pb.PortResult, error) {
+	log.Infof("SetMtu: Ifname %s, MTU %d", req.GetIfname(), req.GetMtu())
+	if err := portMgmt.sw.ports.SetMtu(req.GetIfname(), req.GetMtu()); err != nil {
+		log.Errorf("Failed to set MTU of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) SetFlowControl(ctx context.Context, req *pb.PortFlowControl) (*pb.PortResult, error) {
+	log.Infof("SetFlowControl: Ifname %s, Tx %t, Rx %t", req.GetIfname(), req.GetTx(), req.GetRx())
+	flowControl := PortFlowControl{Tx: req.GetTx(), Rx: req.GetRx()}
+	if err := portMgmt.sw.ports.SetFlowControl(req.GetIfname(), flowControl); err != nil {
+		log.Errorf("Failed to set flow control of port %s: %s", req.GetIfname(), err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) GetPortConfig(ctx context.Context, req *pb.PortIface) (*pb.PortConfigList, error) {
+	result := &pb.PortConfigList{}
+	for _, portName := range requestedPorts(req.GetIfname()) {
+		portConfig, err := portMgmt.sw.ports.PortConfig(portName)
+		if err != nil {
+			log.Errorf("Failed to get configuration of port %s: %s", portName, err)
+			return nil, err
+		}
+
+		result.Configs = append(result.Configs, portConfig)
+	}
+
+	return result, nil
+}
+
+func HandlePortRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", portMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterPortManagementServer(s, &portRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
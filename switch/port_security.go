@@ -0,0 +1,598 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPortSecurity/gRPCServices"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	portSecurityMgmtPort    = ":50055"
+	PORT_SECURITY_REASON    = "port security"
+	RX_PRIO_PORT_SECURITY   = 90
+	RX_NAME_PORT_SECURITY   = "Port security"
+	portSecurityLogInterval = time.Second
+	ethMacAddrLen           = 6
+)
+
+// PortSecurityAction tells what happens when port exceeds the limit of learned addresses.
+type PortSecurityAction int
+
+const (
+	PORT_SECURITY_DROP PortSecurityAction = iota
+	PORT_SECURITY_DROP_AND_LOG
+	PORT_SECURITY_SHUTDOWN
+)
+
+// PortSecurityConfig represents limit of MAC addresses learned on port.
+type PortSecurityConfig struct {
+	MaxMacs         uint32             `json:"maxMacs"`
+	Action          PortSecurityAction `json:"action"`
+	Sticky          bool               `json:"sticky,omitempty"`
+	AutoRecoverySec uint32             `json:"autoRecoverySec,omitempty"`
+}
+
+type portSecurityState struct {
+	macs             map[string]struct{}
+	violations       uint64
+	locked           bool
+	lastViolationMAC net.HardwareAddr
+	lastViolation    time.Time
+	lastLog          time.Time
+}
+
+// portSecurity counts addresses learned on ports and enforces their limits. Once port reaches
+// the limit, learning is locked, so frames of new stations are dropped, or sent to the CPU only
+// to be logged.
+type portSecurity struct {
+	sw    *Switch
+	mtx   sync.Mutex
+	ports map[string]*portSecurityState
+}
+
+func newPortSecurity(sw *Switch) *portSecurity {
+	return &portSecurity{
+		sw:    sw,
+		ports: make(map[string]*portSecurityState),
+	}
+}
+
+func (psec *portSecurity) config(portName string) (PortSecurityConfig, bool) {
+	var cfg PortSecurityConfig
+	var enabled bool
+	psec.sw.cfg.view(func(c *Config) {
+		cfg, enabled = c.PortSecurity[portName]
+	})
+
+	return cfg, enabled
+}
+
+// learningLock returns learning flags of port which reached the limit of addresses.
+func (psec *portSecurity) learningLock(portName string) (bool, opennsl.PortLearnFlags) {
+	cfg, enabled := psec.config(portName)
+	if !enabled {
+		return false, 0
+	}
+
+	psec.mtx.Lock()
+	defer psec.mtx.Unlock()
+
+	state, exists := psec.ports[portName]
+	if !exists || !state.locked {
+		return false, 0
+	}
+
+	if cfg.Action == PORT_SECURITY_DROP_AND_LOG {
+		// Frames from unknown stations go to the CPU only, so they can be logged
+		return true, opennsl.NewPortLearnFlags(opennsl.PORT_LEARN_CPU)
+	}
+
+	return true, opennsl.NewPortLearnFlags()
+}
+
+// updateLock locks or unlocks learning depending on number of addresses. It has to be called
+// with mutex held and returns true if state of lock has changed.
+func (psec *portSecurity) updateLock(cfg PortSecurityConfig, state *portSecurityState) bool {
+	locked := cfg.Action != PORT_SECURITY_SHUTDOWN && uint32(len(state.macs)) >= cfg.MaxMacs
+	changed := locked != state.locked
+	state.locked = locked
+	return changed
+}
+
+func (psec *portSecurity) refreshLearning(portName string) {
+	port, err := portByName(portName)
+	if err != nil {
+		return
+	}
+
+	psec.sw.stpMtx.Lock()
+	defer psec.sw.stpMtx.Unlock()
+
+	if err := psec.sw.refreshLearning(portName, port); err != nil {
+		log.Errorf("Failed to update MAC learning of port %s: %s", portName, err)
+	}
+}
+
+func (psec *portSecurity) makeSticky(portName string, hwAddr net.HardwareAddr, vlan opennsl.Vlan) {
+	entry := StaticFdbEntry{
+		MAC:    hwAddr.String(),
+		Vlan:   uint16(vlan),
+		Ifname: portName,
+		Sticky: true,
+	}
+
+	log.Infof("Making address %s in VLAN %d on port %s sticky", entry.MAC, entry.Vlan, portName)
+	if err := psec.sw.fdb.AddStaticEntry(entry); err != nil {
+		log.Errorf("Failed to make address %s sticky on port %s: %s", entry.MAC, portName, err)
+	}
+}
+
+func (psec *portSecurity) violate(portName string, cfg PortSecurityConfig, hwAddr net.HardwareAddr, vlan opennsl.Vlan) {
+	if cfg.Action == PORT_SECURITY_DROP {
+		log.Debugf("Port security violation on port %s by %s in VLAN %d", portName, hwAddr, vlan)
+	} else {
+		log.Warnf("Port security violation on port %s by %s in VLAN %d, limit of %d addresses reached",
+			portName, hwAddr, vlan, cfg.MaxMacs)
+	}
+
+	if err := opennsl.L2AddrDelete(psec.sw.asic.unit, hwAddr, vlan); err != nil {
+		log.Errorf("Failed to remove address %s exceeding limit of port %s: %s", hwAddr, portName, err)
+	}
+
+	if cfg.Action == PORT_SECURITY_SHUTDOWN {
+		autoRecovery := time.Duration(cfg.AutoRecoverySec) * time.Second
+		if err := psec.sw.ErrDisablePort(portName, PORT_SECURITY_REASON, autoRecovery); err != nil {
+			log.Errorf("Failed to error-disable port %s on port security violation: %s", portName, err)
+		}
+	}
+}
+
+func (psec *portSecurity) learned(portName string, hwAddr net.HardwareAddr, vlan opennsl.Vlan, static bool) {
+	cfg, enabled := psec.config(portName)
+	if !enabled {
+		return
+	}
+
+	key := fdbEntryKey(hwAddr.String(), uint16(vlan))
+	psec.mtx.Lock()
+	state, exists := psec.ports[portName]
+	if !exists {
+		psec.mtx.Unlock()
+		return
+	}
+
+	if _, exists := state.macs[key]; exists {
+		psec.mtx.Unlock()
+		return
+	}
+
+	if uint32(len(state.macs)) >= cfg.MaxMacs {
+		state.violations++
+		state.lastViolationMAC = hwAddr
+		state.lastViolation = time.Now()
+		psec.mtx.Unlock()
+		psec.violate(portName, cfg, hwAddr, vlan)
+		return
+	}
+
+	state.macs[key] = struct{}{}
+	lockChanged := psec.updateLock(cfg, state)
+	psec.mtx.Unlock()
+
+	if cfg.Sticky && !static {
+		psec.makeSticky(portName, hwAddr, vlan)
+	}
+
+	if lockChanged {
+		log.Infof("Port %s reached limit of %d MAC addresses, locking learning", portName, cfg.MaxMacs)
+		psec.refreshLearning(portName)
+	}
+}
+
+func (psec *portSecurity) forgotten(portName string, hwAddr net.HardwareAddr, vlan opennsl.Vlan) {
+	cfg, enabled := psec.config(portName)
+	if !enabled {
+		return
+	}
+
+	psec.mtx.Lock()
+	state, exists := psec.ports[portName]
+	if !exists {
+		psec.mtx.Unlock()
+		return
+	}
+
+	delete(state.macs, fdbEntryKey(hwAddr.String(), uint16(vlan)))
+	lockChanged := psec.updateLock(cfg, state)
+	psec.mtx.Unlock()
+
+	if lockChanged {
+		log.Infof("Port %s is below limit of %d MAC addresses, unlocking learning", portName, cfg.MaxMacs)
+		psec.refreshLearning(portName)
+	}
+}
+
+// recount replaces addresses counted on port by its entries in hardware L2 table. It returns
+// true if state of lock has changed.
+func (psec *portSecurity) recount(portName string, cfg PortSecurityConfig, entries []*FdbEntry) bool {
+	macs := make(map[string]struct{})
+	for _, entry := range entries {
+		if entry.Ifname == portName {
+			macs[fdbEntryKey(entry.MAC.String(), uint16(entry.Vlan))] = struct{}{}
+		}
+	}
+
+	psec.mtx.Lock()
+	defer psec.mtx.Unlock()
+
+	state, exists := psec.ports[portName]
+	if !exists {
+		return false
+	}
+
+	state.macs = macs
+	return psec.updateLock(cfg, state)
+}
+
+// flushed counts addresses of ports again after their L2 entries were flushed, so ports locked
+// by the limit can learn again even if some of delete callbacks were missed.
+func (psec *portSecurity) flushed(portNames []string) {
+	var entries []*FdbEntry
+	for _, portName := range portNames {
+		cfg, enabled := psec.config(portName)
+		if !enabled {
+			continue
+		}
+
+		if entries == nil {
+			var err error
+			if entries, err = psec.sw.fdb.Entries(); err != nil {
+				log.Errorf("Failed to count MAC addresses of port %s after flush: %s", portName, err)
+				return
+			}
+		}
+
+		if psec.recount(portName, cfg, entries) {
+			log.Infof("Port %s has %d MAC addresses after flush, updating learning lock", portName, psec.count(portName))
+			psec.refreshLearning(portName)
+		}
+	}
+}
+
+func (psec *portSecurity) count(portName string) int {
+	psec.mtx.Lock()
+	defer psec.mtx.Unlock()
+
+	if state, exists := psec.ports[portName]; exists {
+		return len(state.macs)
+	}
+
+	return 0
+}
+
+func (psec *portSecurity) handleL2AddrEvent(event *L2AddrEvent) {
+	switch event.Type {
+	case L2_ADDR_LEARN:
+		psec.learned(event.Ifname, event.MAC, event.Vlan, event.Static)
+	case L2_ADDR_AGE:
+		psec.forgotten(event.Ifname, event.MAC, event.Vlan)
+	case L2_ADDR_MOVE:
+		psec.forgotten(event.PrevIfname, event.MAC, event.Vlan)
+		psec.learned(event.Ifname, event.MAC, event.Vlan, event.Static)
+	}
+}
+
+// handleRxPacket counts frames of unknown stations which locked ports send to the CPU.
+func (psec *portSecurity) handleRxPacket(unit int, pkt *opennsl.Pkt) opennsl.RxResult {
+	if !pkt.RxReasons().Has(opennsl.RxReasonL2SourceMiss) {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	portName, err := portNameOf(pkt.SrcPort())
+	if err != nil {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	cfg, enabled := psec.config(portName)
+	if !enabled {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	data := pkt.Data()
+	if len(data) < 2*ethMacAddrLen {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	// Source MAC address follows the destination one
+	srcMAC := net.HardwareAddr(append([]byte(nil), data[ethMacAddrLen:2*ethMacAddrLen]...))
+	psec.mtx.Lock()
+	state, exists := psec.ports[portName]
+	if !exists || !state.locked {
+		psec.mtx.Unlock()
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	now := time.Now()
+	state.violations++
+	state.lastViolationMAC = srcMAC
+	state.lastViolation = now
+	logIt := now.Sub(state.lastLog) >= portSecurityLogInterval
+	if logIt {
+		state.lastLog = now
+	}
+
+	psec.mtx.Unlock()
+
+	if logIt {
+		log.Warnf("Port security violation on port %s by %s in VLAN %d, limit of %d addresses reached",
+			portName, srcMAC, pkt.VlanID(), cfg.MaxMacs)
+	}
+
+	return opennsl.RX_HANDLED
+}
+
+// enable starts counting addresses of port, the ones already learned included.
+func (psec *portSecurity) enable(portName string, cfg PortSecurityConfig) error {
+	entries, err := psec.sw.fdb.Entries()
+	if err != nil {
+		return err
+	}
+
+	state := &portSecurityState{macs: make(map[string]struct{})}
+	var toSticky []*FdbEntry
+	for _, entry := range entries {
+		if entry.Ifname != portName {
+			continue
+		}
+
+		state.macs[fdbEntryKey(entry.MAC.String(), uint16(entry.Vlan))] = struct{}{}
+		if cfg.Sticky && !entry.Static {
+			toSticky = append(toSticky, entry)
+		}
+	}
+
+	if uint32(len(state.macs)) > cfg.MaxMacs {
+		log.Warnf("Port %s has already learned %d addresses, more than limit of %d", portName, len(state.macs), cfg.MaxMacs)
+	}
+
+	psec.mtx.Lock()
+	psec.updateLock(cfg, state)
+	psec.ports[portName] = state
+	psec.mtx.Unlock()
+
+	for _, entry := range toSticky {
+		psec.makeSticky(portName, entry.MAC, entry.Vlan)
+	}
+
+	psec.refreshLearning(portName)
+	return nil
+}
+
+func (psec *portSecurity) set(portName string, cfg PortSecurityConfig, enabled bool) error {
+	if err := psec.sw.cfg.update(func(c *Config) {
+		if enabled {
+			c.PortSecurity[portName] = cfg
+		} else {
+			delete(c.PortSecurity, portName)
+		}
+	}); err != nil {
+		return err
+	}
+
+	if enabled {
+		return psec.enable(portName, cfg)
+	}
+
+	psec.mtx.Lock()
+	delete(psec.ports, portName)
+	psec.mtx.Unlock()
+
+	psec.refreshLearning(portName)
+	return nil
+}
+
+// ClearStickyMacs removes addresses which have been made static by sticky port security.
+func (psec *portSecurity) ClearStickyMacs(portName string) error {
+	var entries []StaticFdbEntry
+	psec.sw.cfg.view(func(c *Config) {
+		for _, entry := range c.StaticFdb {
+			if entry.Sticky && entry.Ifname == portName {
+				entries = append(entries, entry)
+			}
+		}
+	})
+
+	for _, entry := range entries {
+		hwAddr, vid, err := parseFdbAddr(entry.MAC, uint32(entry.Vlan))
+		if err != nil {
+			return err
+		}
+
+		if err := psec.sw.fdb.DeleteEntry(hwAddr, vid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (psec *portSecurity) run(events chan interface{}) {
+	for ev := range events {
+		psec.handleL2AddrEvent(ev.(*L2AddrEvent))
+	}
+}
+
+// restore enables port security on configured ports and starts following L2 address events.
+func (psec *portSecurity) restore() error {
+	events := psec.sw.l2Events.subscribe()
+	configs := make(map[string]PortSecurityConfig)
+	psec.sw.cfg.view(func(c *Config) {
+		for portName, cfg := range c.PortSecurity {
+			configs[portName] = cfg
+		}
+	})
+
+	for portName, cfg := range configs {
+		log.Infof("Restoring port security on port %s: limit %d, action %d, sticky %t", portName, cfg.MaxMacs, cfg.Action, cfg.Sticky)
+		if err := psec.enable(portName, cfg); err != nil {
+			log.Errorf("Failed to restore port security on port %s: %s", portName, err)
+		}
+	}
+
+	go psec.run(events)
+	return nil
+}
+
+type portSecurityRequestMgmt struct {
+	pb.UnimplementedPortSecurityServer
+	sw *Switch
+}
+
+var portSecurityActions = map[pb.PortSecurityConfig_Action]PortSecurityAction{
+	pb.PortSecurityConfig_DROP:         PORT_SECURITY_DROP,
+	pb.PortSecurityConfig_DROP_AND_LOG: PORT_SECURITY_DROP_AND_LOG,
+	pb.PortSecurityConfig_SHUTDOWN:     PORT_SECURITY_SHUTDOWN,
+}
+
+func (psecMgmt *portSecurityRequestMgmt) SetPortSecurity(ctx context.Context, req *pb.PortSecurityConfig) (*pb.PortSecurityResult, error) {
+	portName := req.GetInterface().GetIfname()
+	log.Infof("SetPortSecurity: Ifname %s, enabled %t, max MACs %d, action %s, sticky %t",
+		portName, req.GetEnabled(), req.GetMaxMacs(), req.GetAction(), req.GetSticky())
+	if _, err := psecMgmt.sw.ResolvePort(portName); err != nil {
+		log.Errorf("%s", err)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, err
+	}
+
+	action, exists := portSecurityActions[req.GetAction()]
+	if !exists {
+		errMsg := fmt.Sprintf("Invalid port security action %d", req.GetAction())
+		log.Errorf(errMsg)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, fmt.Errorf(errMsg)
+	}
+
+	if req.GetEnabled() && req.GetMaxMacs() == 0 {
+		errMsg := fmt.Sprintf("Limit of MAC addresses on port %s has to be positive", portName)
+		log.Errorf(errMsg)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, fmt.Errorf(errMsg)
+	}
+
+	cfg := PortSecurityConfig{
+		MaxMacs:         req.GetMaxMacs(),
+		Action:          action,
+		Sticky:          req.GetSticky(),
+		AutoRecoverySec: req.GetAutoRecoverySec(),
+	}
+
+	if err := psecMgmt.sw.portSecurity.set(portName, cfg, req.GetEnabled()); err != nil {
+		log.Errorf("Failed to set port security on port %s: %s", portName, err)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, fmt.Errorf("Failed to set port security on port %s: %s", portName, err)
+	}
+
+	return &pb.PortSecurityResult{Result: pb.PortSecurityResult_SUCCESS}, nil
+}
+
+func (psecMgmt *portSecurityRequestMgmt) status(portName string) *pb.PortSecurityStatus {
+	psec := psecMgmt.sw.portSecurity
+	cfg, enabled := psec.config(portName)
+	status := &pb.PortSecurityStatus{
+		Config: &pb.PortSecurityConfig{
+			Interface:       &pb.PortSecurityIface{Ifname: portName},
+			Enabled:         enabled,
+			MaxMacs:         cfg.MaxMacs,
+			Sticky:          cfg.Sticky,
+			AutoRecoverySec: cfg.AutoRecoverySec,
+		},
+		ErrDisabled: psecMgmt.sw.IsErrDisabled(portName),
+	}
+
+	for pbAction, action := range portSecurityActions {
+		if action == cfg.Action {
+			status.Config.Action = pbAction
+		}
+	}
+
+	psecMgmt.sw.cfg.view(func(c *Config) {
+		for _, entry := range c.StaticFdb {
+			if entry.Sticky && entry.Ifname == portName {
+				status.StickyMacCount++
+			}
+		}
+	})
+
+	psec.mtx.Lock()
+	defer psec.mtx.Unlock()
+
+	if state, exists := psec.ports[portName]; exists {
+		status.MacCount = uint32(len(state.macs))
+		status.Violations = state.violations
+		status.LearningLocked = state.locked
+		if state.lastViolationMAC != nil {
+			status.LastViolationMac = state.lastViolationMAC.String()
+			status.LastViolationTimestamp = state.lastViolation.Unix()
+		}
+	}
+
+	return status
+}
+
+func (psecMgmt *portSecurityRequestMgmt) GetPortSecurity(ctx context.Context, req *pb.PortSecurityIface) (*pb.PortSecurityStatusList, error) {
+	var portNames []string
+	if len(req.GetIfname()) > 0 {
+		if _, err := psecMgmt.sw.ResolvePort(req.GetIfname()); err != nil {
+			log.Errorf("%s", err)
+			return nil, err
+		}
+
+		portNames = []string{req.GetIfname()}
+	} else {
+		psecMgmt.sw.cfg.view(func(c *Config) {
+			for portName := range c.PortSecurity {
+				portNames = append(portNames, portName)
+			}
+		})
+
+		sort.Strings(portNames)
+	}
+
+	result := &pb.PortSecurityStatusList{}
+	for _, portName := range portNames {
+		result.Statuses = append(result.Statuses, psecMgmt.status(portName))
+	}
+
+	return result, nil
+}
+
+func (psecMgmt *portSecurityRequestMgmt) ClearStickyMacs(ctx context.Context, req *pb.PortSecurityIface) (*pb.PortSecurityResult, error) {
+	portName := req.GetIfname()
+	log.Infof("ClearStickyMacs: Ifname %s", portName)
+	if _, err := psecMgmt.sw.ResolvePort(portName); err != nil {
+		log.Errorf("%s", err)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, err
+	}
+
+	if err := psecMgmt.sw.portSecurity.ClearStickyMacs(portName); err != nil {
+		log.Errorf("Failed to clear sticky addresses of port %s: %s", portName, err)
+		return &pb.PortSecurityResult{Result: pb.PortSecurityResult_FAILED}, fmt.Errorf("Failed to clear sticky addresses of port %s: %s", portName, err)
+	}
+
+	return &pb.PortSecurityResult{Result: pb.PortSecurityResult_SUCCESS}, nil
+}
+
+func HandlePortSecurityRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", portSecurityMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterPortSecurityServer(s, &portSecurityRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package bcm
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+func TestPortSecurityLearnsAgainAfterFlush(t *testing.T) {
+	// Port unknown to the port map keeps the test away from the SDK
+	const portName = "test0"
+	dir, err := ioutil.TempDir("", "port-security")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+	sw := NewSwitch()
+	sw.cfg = newConfigStore(filepath.Join(dir, "config.json"))
+	cfg := PortSecurityConfig{MaxMacs: 2, Action: PORT_SECURITY_DROP}
+	if err := sw.cfg.update(func(c *Config) {
+		c.PortSecurity[portName] = cfg
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	psec := sw.portSecurity
+	psec.ports[portName] = &portSecurityState{macs: make(map[string]struct{})}
+	for _, mac := range []string{"02:00:00:00:00:01", "02:00:00:00:00:02"} {
+		hwAddr, _ := net.ParseMAC(mac)
+		psec.learned(portName, hwAddr, opennsl.Vlan(1), false)
+	}
+
+	if locked, _ := psec.learningLock(portName); !locked {
+		t.Fatalf("Port with %d addresses is not locked at limit of %d", psec.count(portName), cfg.MaxMacs)
+	}
+
+	// Flush leaves no address of the port in hardware
+	psec.recount(portName, cfg, nil)
+	if locked, _ := psec.learningLock(portName); locked {
+		t.Fatalf("Port is still locked after flush")
+	}
+
+	hwAddr, _ := net.ParseMAC("02:00:00:00:00:03")
+	psec.learned(portName, hwAddr, opennsl.Vlan(1), false)
+	if count := psec.count(portName); count != 1 {
+		t.Fatalf("Port has %d addresses after learning one following flush, expected 1", count)
+	}
+
+	if violations := psec.ports[portName].violations; violations != 0 {
+		t.Fatalf("Learning after flush caused %d violations", violations)
+	}
+}
@@ -0,0 +1,328 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPort/gRPCServices"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_STATS_INTERVAL = 10 * time.Second
+	STATS_HISTORY_LEN      = 60
+)
+
+// PortCounter indexes counters polled from SDK.
+type PortCounter int
+
+const (
+	COUNTER_IN_OCTETS PortCounter = iota
+	COUNTER_IN_UCAST_PKTS
+	COUNTER_IN_MULTICAST_PKTS
+	COUNTER_IN_BROADCAST_PKTS
+	COUNTER_IN_DISCARDS
+	COUNTER_IN_ERRORS
+	COUNTER_IN_FCS_ERRORS
+	COUNTER_OUT_OCTETS
+	COUNTER_OUT_UCAST_PKTS
+	COUNTER_OUT_MULTICAST_PKTS
+	COUNTER_OUT_BROADCAST_PKTS
+	COUNTER_OUT_DISCARDS
+	COUNTER_OUT_ERRORS
+	COUNTER_PKTS_64
+	COUNTER_PKTS_65_TO_127
+	COUNTER_PKTS_128_TO_255
+	COUNTER_PKTS_256_TO_511
+	COUNTER_PKTS_512_TO_1023
+	COUNTER_PKTS_1024_TO_1518
+	COUNTER_PKTS_1519_TO_MAX
+	NUM_OF_PORT_COUNTERS
+)
+
+// SDK statistics in order of PortCounter
+var portCounterStats = []opennsl.StatVal{
+	opennsl.SPL_SNMP_IF_IN_OCTETS,
+	opennsl.SPL_SNMP_IF_IN_UCAST_PKTS,
+	opennsl.SPL_SNMP_IF_IN_MULTICAST_PKTS,
+	opennsl.SPL_SNMP_IF_IN_BROADCAST_PKTS,
+	opennsl.SPL_SNMP_IF_IN_DISCARDS,
+	opennsl.SPL_SNMP_IF_IN_ERRORS,
+	opennsl.SPL_SNMP_DOT3_STATS_FCS_ERRORS,
+	opennsl.SPL_SNMP_IF_OUT_OCTETS,
+	opennsl.SPL_SNMP_IF_OUT_UCAST_PKTS,
+	opennsl.SPL_SNMP_IF_OUT_MULTICAST_PKTS,
+	opennsl.SPL_SNMP_IF_OUT_BROADCAST_PKTS,
+	opennsl.SPL_SNMP_IF_OUT_DISCARDS,
+	opennsl.SPL_SNMP_IF_OUT_ERRORS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_64_OCTETS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_65_TO_127_OCTETS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_128_TO_255_OCTETS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_256_TO_511_OCTETS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_512_TO_1023_OCTETS,
+	opennsl.SPL_SNMP_ETHER_STATS_PKTS_1024_TO_1518_OCTETS,
+	opennsl.SPL_SNMP_BCM_ETHER_STATS_PKTS_1519_TO_9216_OCTETS,
+}
+
+type portCounters [NUM_OF_PORT_COUNTERS]uint64
+
+func (counters *portCounters) inPkts() uint64 {
+	return counters[COUNTER_IN_UCAST_PKTS] + counters[COUNTER_IN_MULTICAST_PKTS] + counters[COUNTER_IN_BROADCAST_PKTS]
+}
+
+func (counters *portCounters) outPkts() uint64 {
+	return counters[COUNTER_OUT_UCAST_PKTS] + counters[COUNTER_OUT_MULTICAST_PKTS] + counters[COUNTER_OUT_BROADCAST_PKTS]
+}
+
+type portRates struct {
+	inBps  uint64
+	outBps uint64
+	inPps  uint64
+	outPps uint64
+}
+
+type portStatsSample struct {
+	time     time.Time
+	counters portCounters
+	rates    portRates
+}
+
+// perSecond returns rate of counter between two samples. Counter which went backwards has
+// been cleared, so its rate is unknown.
+func perSecond(prev uint64, cur uint64, elapsed time.Duration) uint64 {
+	if cur < prev || elapsed <= 0 {
+		return 0
+	}
+
+	return uint64(float64(cur-prev) / elapsed.Seconds())
+}
+
+// portStatsRing keeps the most recent samples of port.
+type portStatsRing struct {
+	samples [STATS_HISTORY_LEN]portStatsSample
+	next    int
+	len     int
+}
+
+func (ring *portStatsRing) push(sample portStatsSample) {
+	ring.samples[ring.next] = sample
+	ring.next = (ring.next + 1) % STATS_HISTORY_LEN
+	if ring.len < STATS_HISTORY_LEN {
+		ring.len++
+	}
+}
+
+func (ring *portStatsRing) last() (portStatsSample, bool) {
+	if ring.len == 0 {
+		return portStatsSample{}, false
+	}
+
+	return ring.samples[(ring.next+STATS_HISTORY_LEN-1)%STATS_HISTORY_LEN], true
+}
+
+// list returns samples from the oldest one.
+func (ring *portStatsRing) list() []portStatsSample {
+	samples := make([]portStatsSample, 0, ring.len)
+	for i := ring.len; i > 0; i-- {
+		samples = append(samples, ring.samples[(ring.next+STATS_HISTORY_LEN-i)%STATS_HISTORY_LEN])
+	}
+
+	return samples
+}
+
+func (ring *portStatsRing) reset() {
+	ring.next = 0
+	ring.len = 0
+}
+
+// portStats polls counters of front panel ports and computes rates from them.
+type portStats struct {
+	sw    *Switch
+	mtx   sync.Mutex
+	rings map[string]*portStatsRing
+}
+
+func newPortStats(sw *Switch) *portStats {
+	stats := &portStats{
+		sw:    sw,
+		rings: make(map[string]*portStatsRing),
+	}
+
+	for _, portNameMap := range PortNames {
+		stats.rings[portNameMap.PortName] = &portStatsRing{}
+	}
+
+	return stats
+}
+
+func (stats *portStats) read(port opennsl.Port) (portCounters, error) {
+	var counters portCounters
+	values, err := opennsl.StatMultiGet(stats.sw.asic.unit, port, portCounterStats)
+	if err := sdkCall("StatMultiGet", err); err != nil {
+		return counters, err
+	}
+
+	copy(counters[:], values)
+	return counters, nil
+}
+
+func (stats *portStats) poll() {
+	for _, portNameMap := range PortNames {
+		counters, err := stats.read(portNameMap.Port)
+		if err != nil {
+			log.Errorf("Failed to read counters of port %s: %s", portNameMap.PortName, err)
+			continue
+		}
+
+		sample := portStatsSample{time: time.Now(), counters: counters}
+		stats.mtx.Lock()
+		ring := stats.rings[portNameMap.PortName]
+		if prev, exists := ring.last(); exists {
+			elapsed := sample.time.Sub(prev.time)
+			sample.rates = portRates{
+				inBps:  8 * perSecond(prev.counters[COUNTER_IN_OCTETS], counters[COUNTER_IN_OCTETS], elapsed),
+				outBps: 8 * perSecond(prev.counters[COUNTER_OUT_OCTETS], counters[COUNTER_OUT_OCTETS], elapsed),
+				inPps:  perSecond(prev.counters.inPkts(), counters.inPkts(), elapsed),
+				outPps: perSecond(prev.counters.outPkts(), counters.outPkts(), elapsed),
+			}
+		}
+
+		ring.push(sample)
+		stats.mtx.Unlock()
+	}
+}
+
+func (stats *portStats) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats.poll()
+		<-ticker.C
+	}
+}
+
+// Clear clears counters of port in hardware together with its history.
+func (stats *portStats) Clear(portName string) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	stats.mtx.Lock()
+	defer stats.mtx.Unlock()
+
+	if err := sdkCall("StatClear", opennsl.StatClear(stats.sw.asic.unit, port)); err != nil {
+		return err
+	}
+
+	stats.rings[portName].reset()
+	return nil
+}
+
+// latest returns the last polled sample of port or reads counters from hardware if
+// polling is not running yet.
+func (stats *portStats) latest(portName string) (portStatsSample, error) {
+	port, err := portByName(portName)
+	if err != nil {
+		return portStatsSample{}, err
+	}
+
+	stats.mtx.Lock()
+	sample, exists := stats.rings[portName].last()
+	stats.mtx.Unlock()
+	if exists {
+		return sample, nil
+	}
+
+	counters, err := stats.read(port)
+	if err != nil {
+		return portStatsSample{}, err
+	}
+
+	return portStatsSample{time: time.Now(), counters: counters}, nil
+}
+
+func (stats *portStats) history(portName string) []portStatsSample {
+	stats.mtx.Lock()
+	defer stats.mtx.Unlock()
+
+	return stats.rings[portName].list()
+}
+
+// StartPortStats starts polling counters of ports.
+func (sw *Switch) StartPortStats(interval time.Duration) {
+	log.Infof("Starting polling of port counters every %s", interval)
+	go sw.stats.run(interval)
+}
+
+func toPbPortStatsSample(sample portStatsSample) *pb.PortStatsSample {
+	counters := &sample.counters
+	return &pb.PortStatsSample{
+		Timestamp: sample.time.Unix(),
+		Counters: &pb.PortCounters{
+			InOctets:         counters[COUNTER_IN_OCTETS],
+			InUcastPkts:      counters[COUNTER_IN_UCAST_PKTS],
+			InMulticastPkts:  counters[COUNTER_IN_MULTICAST_PKTS],
+			InBroadcastPkts:  counters[COUNTER_IN_BROADCAST_PKTS],
+			InDiscards:       counters[COUNTER_IN_DISCARDS],
+			InErrors:         counters[COUNTER_IN_ERRORS],
+			InFcsErrors:      counters[COUNTER_IN_FCS_ERRORS],
+			OutOctets:        counters[COUNTER_OUT_OCTETS],
+			OutUcastPkts:     counters[COUNTER_OUT_UCAST_PKTS],
+			OutMulticastPkts: counters[COUNTER_OUT_MULTICAST_PKTS],
+			OutBroadcastPkts: counters[COUNTER_OUT_BROADCAST_PKTS],
+			OutDiscards:      counters[COUNTER_OUT_DISCARDS],
+			OutErrors:        counters[COUNTER_OUT_ERRORS],
+			Pkts64:           counters[COUNTER_PKTS_64],
+			Pkts65To127:      counters[COUNTER_PKTS_65_TO_127],
+			Pkts128To255:     counters[COUNTER_PKTS_128_TO_255],
+			Pkts256To511:     counters[COUNTER_PKTS_256_TO_511],
+			Pkts512To1023:    counters[COUNTER_PKTS_512_TO_1023],
+			Pkts1024To1518:   counters[COUNTER_PKTS_1024_TO_1518],
+			Pkts1519ToMax:    counters[COUNTER_PKTS_1519_TO_MAX],
+		},
+		Rates: &pb.PortRates{
+			InBps:  sample.rates.inBps,
+			OutBps: sample.rates.outBps,
+			InPps:  sample.rates.inPps,
+			OutPps: sample.rates.outPps,
+		},
+	}
+}
+
+func (portMgmt *portRequestMgmt) GetPortStats(ctx context.Context, req *pb.PortStatsRequest) (*pb.PortStatsList, error) {
+	result := &pb.PortStatsList{}
+	for _, portName := range requestedPorts(req.GetIfname()) {
+		sample, err := portMgmt.sw.stats.latest(portName)
+		if err != nil {
+			log.Errorf("Failed to get statistics of port %s: %s", portName, err)
+			return nil, err
+		}
+
+		portStats := &pb.PortStats{Ifname: portName, Current: toPbPortStatsSample(sample)}
+		if req.GetHistory() {
+			for _, sample := range portMgmt.sw.stats.history(portName) {
+				portStats.History = append(portStats.History, toPbPortStatsSample(sample))
+			}
+		}
+
+		result.Stats = append(result.Stats, portStats)
+	}
+
+	return result, nil
+}
+
+func (portMgmt *portRequestMgmt) ClearPortStats(ctx context.Context, req *pb.PortIface) (*pb.PortResult, error) {
+	log.Infof("ClearPortStats: Ifname %s", req.GetIfname())
+	for _, portName := range requestedPorts(req.GetIfname()) {
+		if err := portMgmt.sw.stats.Clear(portName); err != nil {
+			log.Errorf("Failed to clear statistics of port %s: %s", portName, err)
+			return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+		}
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
@@ -0,0 +1,100 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPort/gRPCServices"
+	"context"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+)
+
+// PortStatus reads operational state of port and identifiers of port in SDK and kernel.
+func (ports *portManager) PortStatus(portName string) (*pb.PortStatus, error) {
+	port, err := portByName(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	link := ports.sw.links.link(portName)
+	result := &pb.PortStatus{
+		Ifname:     portName,
+		OperStatus: pb.PortStatus_DOWN,
+		Flaps:      link.flaps,
+		Port:       int32(port),
+		Gport:      int32(opennsl.GPortFromLocal(port)),
+	}
+
+	if ports.sw.IsErrDisabled(portName) {
+		result.OperStatus = pb.PortStatus_ERR_DISABLED
+	} else if ports.sw.portAdminDown(portName) {
+		result.OperStatus = pb.PortStatus_ADMIN_DOWN
+	} else if link.up {
+		result.OperStatus = pb.PortStatus_UP
+		result.LinkUpTime = link.since.Unix()
+	}
+
+	if result.Speed, err = ports.readSpeed(portName, port); err != nil {
+		return nil, err
+	}
+
+	if result.Fec, err = ports.readFec(portName, port); err != nil {
+		return nil, err
+	}
+
+	// Netdev is missing until KNET creates it
+	if netdev, err := netlink.LinkByName(portName); err == nil {
+		result.Ifindex = int32(netdev.Attrs().Index)
+	}
+
+	return result, nil
+}
+
+func (portMgmt *portRequestMgmt) GetPortStatus(ctx context.Context, req *pb.PortIface) (*pb.PortStatusList, error) {
+	result := &pb.PortStatusList{}
+	for _, portName := range requestedPorts(req.GetIfname()) {
+		portStatus, err := portMgmt.sw.ports.PortStatus(portName)
+		if err != nil {
+			log.Errorf("Failed to get status of port %s: %s", portName, err)
+			return nil, err
+		}
+
+		result.Statuses = append(result.Statuses, portStatus)
+	}
+
+	return result, nil
+}
+
+// WatchLinkEvents streams link events of given port or of all ports if name is empty.
+func (portMgmt *portRequestMgmt) WatchLinkEvents(req *pb.PortIface, stream pb.PortManagement_WatchLinkEventsServer) error {
+	portName := req.GetIfname()
+	if len(portName) > 0 {
+		if _, err := portByName(portName); err != nil {
+			log.Errorf("%s", err)
+			return err
+		}
+	}
+
+	events := portMgmt.sw.linkEvents.subscribe()
+	defer portMgmt.sw.linkEvents.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev := <-events:
+			event := ev.(*LinkEvent)
+			if len(portName) > 0 && portName != event.PortName {
+				continue
+			}
+
+			if err := stream.Send(&pb.PortLinkEvent{
+				Ifname:    event.PortName,
+				LinkUp:    event.Up,
+				Timestamp: event.Time.Unix(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
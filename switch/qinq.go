@@ -0,0 +1,440 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchVlan/gRPCServices"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_OUTER_TPID = 0x88a8
+	DEFAULT_TPID       = 0x8100
+)
+
+// QinqMode represents service provider tagging mode of port.
+type QinqMode int
+
+const (
+	QINQ_MODE_NONE QinqMode = iota
+	// Frames of customer get outer tag of service VLAN, which is PVID of the port
+	QINQ_MODE_CUSTOMER
+	// Port of provider network, which carries frames with outer tag
+	QINQ_MODE_PROVIDER
+)
+
+var qinqDtagModes = map[QinqMode]opennsl.PortDtagMode{
+	QINQ_MODE_NONE:     opennsl.PORT_DTAG_MODE_NONE,
+	QINQ_MODE_CUSTOMER: opennsl.PORT_DTAG_MODE_EXTERNAL,
+	QINQ_MODE_PROVIDER: opennsl.PORT_DTAG_MODE_INTERNAL,
+}
+
+// QinqPortConfig represents Q-in-Q settings of port or LAG.
+type QinqPortConfig struct {
+	Mode QinqMode `json:"mode"`
+	Tpid uint16   `json:"tpid,omitempty"`
+}
+
+// VlanTranslation represents VLAN translation entry of port or LAG. Zero inner VLAN
+// matches frames regardless of inner tag and zero new inner VLAN leaves it unchanged.
+type VlanTranslation struct {
+	Ifname       string `json:"ifname"`
+	Egress       bool   `json:"egress"`
+	OuterVlan    uint16 `json:"outerVlan"`
+	InnerVlan    uint16 `json:"innerVlan,omitempty"`
+	NewOuterVlan uint16 `json:"newOuterVlan"`
+	NewInnerVlan uint16 `json:"newInnerVlan,omitempty"`
+}
+
+func (entry VlanTranslation) key() string {
+	direction := "ingress"
+	if entry.Egress {
+		direction = "egress"
+	}
+
+	return fmt.Sprintf("%s/%s/%d/%d", entry.Ifname, direction, entry.OuterVlan, entry.InnerVlan)
+}
+
+func (entry VlanTranslation) validate() error {
+	for _, vlan := range []uint16{entry.OuterVlan, entry.NewOuterVlan} {
+		if err := validateVlan(opennsl.Vlan(vlan)); err != nil {
+			return err
+		}
+	}
+
+	for _, vlan := range []uint16{entry.InnerVlan, entry.NewInnerVlan} {
+		if vlan != 0 {
+			if err := validateVlan(opennsl.Vlan(vlan)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (entry VlanTranslation) translateKey() opennsl.VlanTranslateKey {
+	if entry.InnerVlan != 0 {
+		return opennsl.VLAN_TRANSLATE_KEY_PORT_DOUBLE
+	}
+
+	return opennsl.VLAN_TRANSLATE_KEY_PORT_OUTER
+}
+
+func (entry VlanTranslation) action() *opennsl.VlanAction {
+	action := opennsl.NewVlanAction()
+	action.SetNewOuterVlan(opennsl.Vlan(entry.NewOuterVlan))
+	action.SetOuterTag(opennsl.VLAN_ACTION_REPLACE)
+	if entry.NewInnerVlan != 0 {
+		action.SetNewInnerVlan(opennsl.Vlan(entry.NewInnerVlan))
+		if entry.InnerVlan != 0 {
+			action.SetInnerTag(opennsl.VLAN_ACTION_REPLACE)
+		} else {
+			action.SetInnerTag(opennsl.VLAN_ACTION_ADD)
+		}
+	}
+
+	return action
+}
+
+func (vlans *vlanManager) setPortQinq(ports []opennsl.Port, cfg QinqPortConfig) error {
+	tpid := uint16(DEFAULT_TPID)
+	if cfg.Mode == QINQ_MODE_PROVIDER {
+		tpid = DEFAULT_OUTER_TPID
+		if cfg.Tpid != 0 {
+			tpid = cfg.Tpid
+		}
+	}
+
+	for _, port := range ports {
+		if err := opennsl.PortDtagModeSet(vlans.sw.asic.unit, port, qinqDtagModes[cfg.Mode]); err != nil {
+			return err
+		}
+
+		if err := opennsl.PortTpidSet(vlans.sw.asic.unit, port, tpid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vlans *vlanManager) addPortTranslation(ports []opennsl.Port, entry VlanTranslation) error {
+	for _, port := range ports {
+		if entry.Egress {
+			// Egress translation is keyed by port class, which is equal to port number
+			if err := opennsl.PortClassSet(vlans.sw.asic.unit, port, opennsl.PORT_CLASS_VLAN_TRANSLATE_EGRESS, uint32(port)); err != nil {
+				return err
+			}
+
+			if err := opennsl.VlanControlPortSet(vlans.sw.asic.unit, port, opennsl.VLAN_TRANSLATE_EGRESS_ENABLE, 1); err != nil {
+				return err
+			}
+
+			if err := opennsl.VlanTranslateEgressActionAdd(vlans.sw.asic.unit, int(port),
+				opennsl.Vlan(entry.OuterVlan), opennsl.Vlan(entry.InnerVlan), entry.action()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := opennsl.VlanControlPortSet(vlans.sw.asic.unit, port, opennsl.VLAN_TRANSLATE_INGRESS_ENABLE, 1); err != nil {
+			return err
+		}
+
+		if err := opennsl.VlanTranslateActionAdd(vlans.sw.asic.unit, opennsl.GPortFromLocal(port), entry.translateKey(),
+			opennsl.Vlan(entry.OuterVlan), opennsl.Vlan(entry.InnerVlan), entry.action()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vlans *vlanManager) deletePortTranslation(ports []opennsl.Port, entry VlanTranslation) error {
+	for _, port := range ports {
+		var err error
+		if entry.Egress {
+			err = opennsl.VlanTranslateEgressActionDelete(vlans.sw.asic.unit, int(port),
+				opennsl.Vlan(entry.OuterVlan), opennsl.Vlan(entry.InnerVlan))
+		} else {
+			err = opennsl.VlanTranslateActionDelete(vlans.sw.asic.unit, opennsl.GPortFromLocal(port), entry.translateKey(),
+				opennsl.Vlan(entry.OuterVlan), opennsl.Vlan(entry.InnerVlan))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyQinq programs Q-in-Q mode and VLAN translations configured for interface on given ports.
+func (vlans *vlanManager) applyQinq(ifname string, ports []opennsl.Port) error {
+	var qinqCfg QinqPortConfig
+	qinqConfigured := false
+	translations := make([]VlanTranslation, 0)
+	vlans.sw.cfg.view(func(c *Config) {
+		qinqCfg, qinqConfigured = c.QinqPorts[ifname]
+		for _, entry := range c.VlanTranslations {
+			if entry.Ifname == ifname {
+				translations = append(translations, entry)
+			}
+		}
+	})
+
+	if qinqConfigured {
+		if err := vlans.setPortQinq(ports, qinqCfg); err != nil {
+			return fmt.Errorf("Failed to set Q-in-Q mode of %s: %s", ifname, err)
+		}
+	}
+
+	for _, entry := range translations {
+		if err := vlans.addPortTranslation(ports, entry); err != nil {
+			return fmt.Errorf("Failed to add VLAN translation %s: %s", entry.key(), err)
+		}
+	}
+
+	return nil
+}
+
+// releaseQinq removes Q-in-Q mode and VLAN translations configured for interface from given ports.
+func (vlans *vlanManager) releaseQinq(ifname string, ports []opennsl.Port) error {
+	qinqConfigured := false
+	translations := make([]VlanTranslation, 0)
+	vlans.sw.cfg.view(func(c *Config) {
+		_, qinqConfigured = c.QinqPorts[ifname]
+		for _, entry := range c.VlanTranslations {
+			if entry.Ifname == ifname {
+				translations = append(translations, entry)
+			}
+		}
+	})
+
+	for _, entry := range translations {
+		if err := vlans.deletePortTranslation(ports, entry); err != nil {
+			return fmt.Errorf("Failed to delete VLAN translation %s: %s", entry.key(), err)
+		}
+	}
+
+	if qinqConfigured {
+		if err := vlans.setPortQinq(ports, QinqPortConfig{Mode: QINQ_MODE_NONE}); err != nil {
+			return fmt.Errorf("Failed to reset Q-in-Q mode of %s: %s", ifname, err)
+		}
+	}
+
+	return nil
+}
+
+// SetQinqPort sets service provider tagging mode of port or LAG.
+func (vlans *vlanManager) SetQinqPort(ifname string, cfg QinqPortConfig) error {
+	if _, exists := qinqDtagModes[cfg.Mode]; !exists {
+		return fmt.Errorf("Unknown Q-in-Q mode %d", cfg.Mode)
+	}
+
+	if cfg.Mode != QINQ_MODE_PROVIDER && cfg.Tpid != 0 {
+		return fmt.Errorf("Outer TPID can be set only on provider port")
+	}
+
+	iface, err := vlans.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if err := vlans.setPortQinq(ports, cfg); err != nil {
+		return fmt.Errorf("Failed to set Q-in-Q mode of %s: %s", ifname, err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		if cfg.Mode == QINQ_MODE_NONE {
+			delete(c.QinqPorts, ifname)
+		} else {
+			c.QinqPorts[ifname] = cfg
+		}
+	})
+}
+
+// AddTranslation adds VLAN translation entry of port or LAG. Existing entry with the same
+// match is replaced.
+func (vlans *vlanManager) AddTranslation(entry VlanTranslation) error {
+	if err := entry.validate(); err != nil {
+		return err
+	}
+
+	iface, err := vlans.sw.ResolveL2Iface(entry.Ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	var prevEntry VlanTranslation
+	exists := false
+	vlans.sw.cfg.view(func(c *Config) {
+		prevEntry, exists = c.VlanTranslations[entry.key()]
+	})
+
+	if exists {
+		if err := vlans.deletePortTranslation(ports, prevEntry); err != nil {
+			return fmt.Errorf("Failed to replace VLAN translation %s: %s", entry.key(), err)
+		}
+	}
+
+	if err := vlans.addPortTranslation(ports, entry); err != nil {
+		return fmt.Errorf("Failed to add VLAN translation %s: %s", entry.key(), err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		c.VlanTranslations[entry.key()] = entry
+	})
+}
+
+// DeleteTranslation removes VLAN translation entry of port or LAG.
+func (vlans *vlanManager) DeleteTranslation(entry VlanTranslation) error {
+	iface, err := vlans.sw.ResolveL2Iface(entry.Ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	exists := false
+	vlans.sw.cfg.view(func(c *Config) {
+		_, exists = c.VlanTranslations[entry.key()]
+	})
+
+	if !exists {
+		return fmt.Errorf("VLAN translation %s does not exist", entry.key())
+	}
+
+	if err := vlans.deletePortTranslation(ports, entry); err != nil {
+		return fmt.Errorf("Failed to delete VLAN translation %s: %s", entry.key(), err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		delete(c.VlanTranslations, entry.key())
+	})
+}
+
+// listQinq returns Q-in-Q ports and VLAN translations for VLAN service state.
+func (vlans *vlanManager) listQinq() ([]*pb.VlanQinqPort, []*pb.VlanTranslation) {
+	qinqPorts := make([]*pb.VlanQinqPort, 0)
+	translations := make([]*pb.VlanTranslation, 0)
+	vlans.sw.cfg.view(func(c *Config) {
+		for ifname, cfg := range c.QinqPorts {
+			qinqPorts = append(qinqPorts, &pb.VlanQinqPort{
+				Ifname: ifname,
+				Mode:   pb.VlanQinqPort_Mode(cfg.Mode),
+				Tpid:   uint32(cfg.Tpid),
+			})
+		}
+
+		for _, entry := range c.VlanTranslations {
+			translations = append(translations, toPbVlanTranslation(entry))
+		}
+	})
+
+	sort.Slice(qinqPorts, func(i, j int) bool {
+		return qinqPorts[i].Ifname < qinqPorts[j].Ifname
+	})
+	sort.Slice(translations, func(i, j int) bool {
+		if translations[i].Ifname != translations[j].Ifname {
+			return translations[i].Ifname < translations[j].Ifname
+		}
+
+		return translations[i].OuterVid < translations[j].OuterVid
+	})
+
+	return qinqPorts, translations
+}
+
+func toPbVlanTranslation(entry VlanTranslation) *pb.VlanTranslation {
+	direction := pb.VlanTranslation_INGRESS
+	if entry.Egress {
+		direction = pb.VlanTranslation_EGRESS
+	}
+
+	return &pb.VlanTranslation{
+		Ifname:      entry.Ifname,
+		Direction:   direction,
+		OuterVid:    uint32(entry.OuterVlan),
+		InnerVid:    uint32(entry.InnerVlan),
+		NewOuterVid: uint32(entry.NewOuterVlan),
+		NewInnerVid: uint32(entry.NewInnerVlan),
+	}
+}
+
+func fromPbVlanTranslation(req *pb.VlanTranslation) VlanTranslation {
+	return VlanTranslation{
+		Ifname:       req.GetIfname(),
+		Egress:       req.GetDirection() == pb.VlanTranslation_EGRESS,
+		OuterVlan:    uint16(req.GetOuterVid()),
+		InnerVlan:    uint16(req.GetInnerVid()),
+		NewOuterVlan: uint16(req.GetNewOuterVid()),
+		NewInnerVlan: uint16(req.GetNewInnerVid()),
+	}
+}
+
+func (vlanMgmt *vlanRequestMgmt) SetQinqPort(ctx context.Context, req *pb.VlanQinqPort) (*pb.VlanResult, error) {
+	log.Infof("SetQinqPort: Ifname %s, mode %s, TPID 0x%x", req.GetIfname(), req.GetMode(), req.GetTpid())
+	if req.GetTpid() > 0xffff {
+		errMsg := fmt.Sprintf("Invalid TPID 0x%x", req.GetTpid())
+		log.Errorf(errMsg)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, fmt.Errorf(errMsg)
+	}
+
+	cfg := QinqPortConfig{Mode: QinqMode(req.GetMode()), Tpid: uint16(req.GetTpid())}
+	if err := vlanMgmt.sw.vlans.SetQinqPort(req.GetIfname(), cfg); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) AddVlanTranslation(ctx context.Context, req *pb.VlanTranslation) (*pb.VlanResult, error) {
+	entry := fromPbVlanTranslation(req)
+	log.Infof("AddVlanTranslation: %s to %d/%d", entry.key(), entry.NewOuterVlan, entry.NewInnerVlan)
+	if err := vlanMgmt.sw.vlans.AddTranslation(entry); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) DeleteVlanTranslation(ctx context.Context, req *pb.VlanTranslation) (*pb.VlanResult, error) {
+	entry := fromPbVlanTranslation(req)
+	log.Infof("DeleteVlanTranslation: %s", entry.key())
+	if err := vlanMgmt.sw.vlans.DeleteTranslation(entry); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
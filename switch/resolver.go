@@ -0,0 +1,137 @@
+package bcm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+)
+
+// IfaceKind tells what kind of object stands behind the interface name.
+type IfaceKind int
+
+const (
+	IFACE_KIND_PORT IfaceKind = iota
+	IFACE_KIND_LAG
+	IFACE_KIND_VLAN
+	IFACE_KIND_MGMT
+)
+
+var ifaceKindNames = map[IfaceKind]string{
+	IFACE_KIND_PORT: "port",
+	IFACE_KIND_LAG:  "LAG",
+	IFACE_KIND_VLAN: "VLAN interface",
+	IFACE_KIND_MGMT: "management interface",
+}
+
+func (kind IfaceKind) String() string {
+	return ifaceKindNames[kind]
+}
+
+// LogicalIface represents interface name resolved to the switch object.
+type LogicalIface struct {
+	Name  string
+	Kind  IfaceKind
+	Port  opennsl.Port
+	Trunk opennsl.Trunk
+	Vlan  opennsl.Vlan
+	// Names of physical ports standing behind the interface, sorted
+	Members []string
+}
+
+// ResolveIface maps interface name to the physical port, LAG, VLAN interface or
+// management interface registered in the switch.
+func (sw *Switch) ResolveIface(ifname string) (*LogicalIface, error) {
+	if port, err := portByName(ifname); err == nil {
+		return &LogicalIface{Name: ifname, Kind: IFACE_KIND_PORT, Port: port, Members: []string{ifname}}, nil
+	}
+
+	sw.ifaceMtx.RLock()
+	defer sw.ifaceMtx.RUnlock()
+
+	if lag, exists := sw.lagIfaces[ifname]; exists {
+		members := make([]string, 0, len(lag.members))
+		for portName := range lag.members {
+			members = append(members, portName)
+		}
+
+		sort.Strings(members)
+		return &LogicalIface{Name: ifname, Kind: IFACE_KIND_LAG, Trunk: lag.trunk, Members: members}, nil
+	}
+
+	if vlan, exists := sw.vlanIfaces[ifname]; exists {
+		return &LogicalIface{Name: ifname, Kind: IFACE_KIND_VLAN, Vlan: vlan}, nil
+	}
+
+	if mgmtIface, exists := sw.mgmtIfaces[ifname]; exists {
+		return &LogicalIface{Name: ifname, Kind: IFACE_KIND_MGMT, Vlan: mgmtIface.vlan}, nil
+	}
+
+	return nil, fmt.Errorf("Interface %s does not exist", ifname)
+}
+
+// ResolveL2Iface resolves interface name which has to be a physical port or a LAG.
+func (sw *Switch) ResolveL2Iface(ifname string) (*LogicalIface, error) {
+	iface, err := sw.ResolveIface(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	if iface.Kind != IFACE_KIND_PORT && iface.Kind != IFACE_KIND_LAG {
+		return nil, fmt.Errorf("Interface %s is a %s, not a port or LAG", ifname, iface.Kind)
+	}
+
+	return iface, nil
+}
+
+// ResolvePort resolves interface name which has to be a physical port.
+func (sw *Switch) ResolvePort(ifname string) (*LogicalIface, error) {
+	iface, err := sw.ResolveIface(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	if iface.Kind != IFACE_KIND_PORT {
+		return nil, fmt.Errorf("Interface %s is a %s, not a port", ifname, iface.Kind)
+	}
+
+	return iface, nil
+}
+
+// lagNameOf returns name of LAG backed by the trunk.
+func (sw *Switch) lagNameOf(trunk opennsl.Trunk) (string, error) {
+	sw.ifaceMtx.RLock()
+	defer sw.ifaceMtx.RUnlock()
+
+	for lagIfname, lag := range sw.lagIfaces {
+		if lag.trunk == trunk {
+			return lagIfname, nil
+		}
+	}
+
+	return "", fmt.Errorf("Trunk %d does not belong to any LAG", trunk)
+}
+
+// AddVlanIface registers name of VLAN interface.
+func (sw *Switch) AddVlanIface(ifname string, vlan opennsl.Vlan) {
+	sw.ifaceMtx.Lock()
+	defer sw.ifaceMtx.Unlock()
+
+	sw.vlanIfaces[ifname] = vlan
+}
+
+// DeleteVlanIface unregisters name of VLAN interface.
+func (sw *Switch) DeleteVlanIface(ifname string) {
+	sw.ifaceMtx.Lock()
+	defer sw.ifaceMtx.Unlock()
+
+	delete(sw.vlanIfaces, ifname)
+}
+
+// AddMgmtIface registers management interface.
+func (sw *Switch) AddMgmtIface(mgmtIface *MgmtIface) {
+	sw.ifaceMtx.Lock()
+	defer sw.ifaceMtx.Unlock()
+
+	sw.mgmtIfaces[mgmtIface.ifaceName] = mgmtIface
+}
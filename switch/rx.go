@@ -2,6 +2,9 @@ package bcm
 
 import "github.com/beluganos/go-opennsl/opennsl"
 
+// RxHandler processes packets which KNET passes to the SDK Rx API.
+type RxHandler func(unit int, pkt *opennsl.Pkt) opennsl.RxResult
+
 type Rx struct {
 	cfg *opennsl.RxCfg
 }
@@ -29,6 +32,23 @@ func (rx *Rx) Start() error {
 	return nil
 }
 
+func (rx *Rx) RegisterHandler(name string, prio uint8, handler RxHandler) error {
+	handled := rxPackets.WithLabelValues(name, "true")
+	notHandled := rxPackets.WithLabelValues(name, "false")
+	counted := func(unit int, pkt *opennsl.Pkt) opennsl.RxResult {
+		result := handler(unit, pkt)
+		if result == opennsl.RX_HANDLED {
+			handled.Inc()
+		} else {
+			notHandled.Inc()
+		}
+
+		return result
+	}
+
+	return opennsl.RxRegister(DEFAULT_ASIC_UNIT, name, opennsl.RxCallback(counted), prio, opennsl.NewRxCallbackFlags(opennsl.RCO_F_ALL_COS))
+}
+
 func (rx *Rx) Stop() error {
 	return rx.cfg.Stop(DEFAULT_ASIC_UNIT)
 }
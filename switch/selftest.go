@@ -0,0 +1,348 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPort/gRPCServices"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	RX_PRIO_SELF_TEST            = 110
+	RX_NAME_SELF_TEST            = "self-test"
+	DEFAULT_SELF_TEST_FRAMES     = 100
+	MAX_SELF_TEST_FRAMES         = 10000
+	DEFAULT_SELF_TEST_FRAME_SIZE = 512
+	MIN_SELF_TEST_FRAME_SIZE     = 64
+	// IEEE local experimental EtherType
+	selfTestEtherType = 0x88b5
+	// Ethernet header, test ID and sequence number
+	selfTestHeaderLen = 14 + 4 + 4
+	fcsLen            = 4
+	// Time for link to come up after loopback is set
+	selfTestSettleTime = 500 * time.Millisecond
+	selfTestRxTimeout  = time.Second
+)
+
+var (
+	// Reserved multicast address is trapped to CPU also on blocked ports
+	selfTestDstMAC, _ = net.ParseMAC("01:80:c2:00:00:00")
+	selfTestSrcMAC, _ = net.ParseMAC("02:00:00:00:00:02")
+	selfTestMagic     = []byte{'B', 'C', 'M', 'T'}
+)
+
+// SelfTestLoopback tells where frames are looped back on port.
+type SelfTestLoopback int
+
+const (
+	SELF_TEST_MAC_LOOPBACK SelfTestLoopback = iota
+	SELF_TEST_PHY_LOOPBACK
+)
+
+var selfTestLoopbacks = map[SelfTestLoopback]opennsl.PortLoopback{
+	SELF_TEST_MAC_LOOPBACK: opennsl.PORT_LOOPBACK_MAC,
+	SELF_TEST_PHY_LOOPBACK: opennsl.PORT_LOOPBACK_PHY,
+}
+
+// SelfTestResult represents outcome of loopback test of port.
+type SelfTestResult struct {
+	Sent      uint32
+	Received  uint32
+	Corrupted uint32
+	RxErrors  uint64
+	FcsErrors uint64
+	TxErrors  uint64
+}
+
+func (result SelfTestResult) Passed() bool {
+	return result.Sent > 0 && result.Received == result.Sent && result.Corrupted == 0 &&
+		result.RxErrors == 0 && result.FcsErrors == 0 && result.TxErrors == 0
+}
+
+// selfTestRun counts test frames looped back on tested port.
+type selfTestRun struct {
+	port      opennsl.Port
+	id        uint32
+	frameSize int
+	expected  uint32
+	received  uint32
+	corrupted uint32
+	done      chan struct{}
+}
+
+type selfTest struct {
+	sw *Switch
+	// Only one port is tested at a time
+	mtx    sync.Mutex
+	rxMtx  sync.Mutex
+	active *selfTestRun
+	nextID uint32
+}
+
+func newSelfTest(sw *Switch) *selfTest {
+	return &selfTest{sw: sw}
+}
+
+// selfTestFrame builds test frame without FCS. Payload is derived from test ID and sequence
+// number, so corruption is detected on receive.
+func selfTestFrame(id uint32, seq uint32, size int) []byte {
+	frame := make([]byte, size-fcsLen)
+	copy(frame[0:6], selfTestDstMAC)
+	copy(frame[6:12], selfTestSrcMAC)
+	binary.BigEndian.PutUint16(frame[12:14], selfTestEtherType)
+	binary.BigEndian.PutUint32(frame[14:18], id)
+	binary.BigEndian.PutUint32(frame[18:22], seq)
+	copy(frame[selfTestHeaderLen:], selfTestMagic)
+	for i := selfTestHeaderLen + len(selfTestMagic); i < len(frame); i++ {
+		frame[i] = byte(seq) + byte(i)
+	}
+
+	return frame
+}
+
+// handleRxPacket counts test frames received on tested port.
+func (test *selfTest) handleRxPacket(unit int, pkt *opennsl.Pkt) opennsl.RxResult {
+	test.rxMtx.Lock()
+	defer test.rxMtx.Unlock()
+
+	run := test.active
+	if run == nil || pkt.SrcPort() != run.port {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	data := pkt.Data()
+	// Received frame may carry VLAN tag of port
+	if len(data) >= 16 && binary.BigEndian.Uint16(data[12:14]) == DEFAULT_TPID {
+		data = append(append([]byte{}, data[:12]...), data[16:]...)
+	}
+
+	if len(data) < selfTestHeaderLen+len(selfTestMagic) || binary.BigEndian.Uint16(data[12:14]) != selfTestEtherType {
+		return opennsl.RX_NOT_HANDLED
+	}
+
+	// Frame left from previous test
+	if binary.BigEndian.Uint32(data[14:18]) != run.id {
+		return opennsl.RX_HANDLED
+	}
+
+	// Data may be followed by FCS left by SDK
+	seq := binary.BigEndian.Uint32(data[18:22])
+	expected := selfTestFrame(run.id, seq, run.frameSize)
+	if len(data) >= len(expected) && bytes.Equal(data[:len(expected)], expected) {
+		run.received++
+	} else {
+		run.corrupted++
+	}
+
+	if run.received+run.corrupted == run.expected {
+		close(run.done)
+	}
+
+	return opennsl.RX_HANDLED
+}
+
+// checkPort refuses ports which carry traffic.
+func (test *selfTest) checkPort(portName string, port opennsl.Port) error {
+	test.sw.ifaceMtx.RLock()
+	for lagIfname, lag := range test.sw.lagIfaces {
+		if _, exists := lag.members[portName]; exists {
+			test.sw.ifaceMtx.RUnlock()
+			return fmt.Errorf("Port %s is member of LAG %s", portName, lagIfname)
+		}
+	}
+	test.sw.ifaceMtx.RUnlock()
+
+	// Port may forward in any spanning tree group, not just in the default one
+	stgs, err := opennsl.StgListGet(test.sw.asic.unit)
+	if err != nil {
+		return fmt.Errorf("Failed to list STGs: %s", err)
+	}
+
+	for _, stg := range stgs {
+		state, err := stg.StpGet(test.sw.asic.unit, port)
+		if err != nil {
+			return err
+		}
+
+		if state == opennsl.STG_STP_FORWARD {
+			return fmt.Errorf("Port %s is forwarding in STG %d", portName, stg)
+		}
+	}
+
+	return nil
+}
+
+// send transmits test frames from CPU directly to port.
+func (test *selfTest) send(run *selfTestRun) uint32 {
+	unit := test.sw.asic.unit
+	sent := uint32(0)
+	for seq := uint32(0); seq < run.expected; seq++ {
+		pkt, err := opennsl.PktAlloc(unit, run.frameSize, opennsl.PKT_F_NONE)
+		if err != nil {
+			sdkCall("PktAlloc", err)
+			log.Errorf("Failed to allocate test frame: %s", err)
+			break
+		}
+
+		if err := pkt.SetData(selfTestFrame(run.id, seq, run.frameSize)); err != nil {
+			log.Errorf("Failed to fill test frame: %s", err)
+		} else {
+			pkt.SetTxPort(run.port)
+			if err := sdkCall("Tx", opennsl.Tx(unit, pkt)); err != nil {
+				log.Errorf("Failed to send test frame %d: %s", seq, err)
+			} else {
+				sent++
+			}
+		}
+
+		opennsl.PktFree(unit, pkt)
+	}
+
+	return sent
+}
+
+// Run loops test frames on port and restores the port afterwards.
+func (test *selfTest) Run(portName string, loopback SelfTestLoopback, frames uint32, frameSize uint32, force bool) (*SelfTestResult, error) {
+	sdkLoopback, exists := selfTestLoopbacks[loopback]
+	if !exists {
+		return nil, fmt.Errorf("Unknown loopback %d", loopback)
+	}
+
+	if frames == 0 {
+		frames = DEFAULT_SELF_TEST_FRAMES
+	}
+
+	if frameSize == 0 {
+		frameSize = DEFAULT_SELF_TEST_FRAME_SIZE
+	}
+
+	if frames > MAX_SELF_TEST_FRAMES {
+		return nil, fmt.Errorf("Number of test frames %d is above %d", frames, MAX_SELF_TEST_FRAMES)
+	}
+
+	if frameSize < MIN_SELF_TEST_FRAME_SIZE || frameSize > MAX_FRAME_SIZE {
+		return nil, fmt.Errorf("Size of test frames %d is out of range %d-%d", frameSize, MIN_SELF_TEST_FRAME_SIZE, MAX_FRAME_SIZE)
+	}
+
+	port, err := portByName(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	l2Port, err := test.sw.l2Port(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !force {
+		if err := test.checkPort(portName, port); err != nil {
+			return nil, fmt.Errorf("%s, refusing to test it without force", err)
+		}
+	}
+
+	test.mtx.Lock()
+	defer test.mtx.Unlock()
+
+	unit := test.sw.asic.unit
+	prevLoopback, err := opennsl.PortLoopbackGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	prevEnable, err := opennsl.PortEnableGet(unit, port)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := opennsl.PortLoopbackSet(unit, port, prevLoopback); err != nil {
+			log.Errorf("Failed to restore loopback of port %s: %s", portName, err)
+		}
+
+		if err := opennsl.PortEnableSet(unit, port, prevEnable); err != nil {
+			log.Errorf("Failed to restore enable state of port %s: %s", portName, err)
+		}
+
+		if err := l2Port.SetBpduDestination(test.sw.bpduProtection.config(portName).knetDest()); err != nil {
+			log.Errorf("Failed to restore delivery of BPDUs of port %s: %s", portName, err)
+		}
+
+		log.Infof("Finished self-test of port %s", portName)
+	}()
+
+	log.Infof("Starting self-test of port %s with %d frames of %d octets", portName, frames, frameSize)
+	// Test frames are trapped as BPDUs, which have to reach the daemon
+	if err := l2Port.SetBpduDestination(opennsl.KNET_DEST_T_BCM_RX_API); err != nil {
+		return nil, err
+	}
+
+	if err := opennsl.PortEnableSet(unit, port, opennsl.TRUE); err != nil {
+		return nil, err
+	}
+
+	if err := opennsl.PortLoopbackSet(unit, port, sdkLoopback); err != nil {
+		return nil, err
+	}
+
+	time.Sleep(selfTestSettleTime)
+	before, err := test.sw.stats.read(port)
+	if err != nil {
+		return nil, err
+	}
+
+	test.nextID++
+	run := &selfTestRun{port: port, id: test.nextID, frameSize: int(frameSize), expected: frames, done: make(chan struct{})}
+	test.rxMtx.Lock()
+	test.active = run
+	test.rxMtx.Unlock()
+
+	sent := test.send(run)
+	select {
+	case <-run.done:
+	case <-time.After(selfTestRxTimeout):
+	}
+
+	test.rxMtx.Lock()
+	test.active = nil
+	result := &SelfTestResult{Sent: sent, Received: run.received, Corrupted: run.corrupted}
+	test.rxMtx.Unlock()
+
+	after, err := test.sw.stats.read(port)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RxErrors = after[COUNTER_IN_ERRORS] - before[COUNTER_IN_ERRORS]
+	result.FcsErrors = after[COUNTER_IN_FCS_ERRORS] - before[COUNTER_IN_FCS_ERRORS]
+	result.TxErrors = after[COUNTER_OUT_ERRORS] - before[COUNTER_OUT_ERRORS]
+	return result, nil
+}
+
+func (portMgmt *portRequestMgmt) RunSelfTest(ctx context.Context, req *pb.PortSelfTest) (*pb.PortSelfTestResult, error) {
+	portName := req.GetIfname()
+	log.Infof("RunSelfTest: Ifname %s, loopback %s, frames %d, size %d, force %t",
+		portName, req.GetLoopback(), req.GetFrames(), req.GetFrameSize(), req.GetForce())
+	result, err := portMgmt.sw.selfTest.Run(portName, SelfTestLoopback(req.GetLoopback()), req.GetFrames(), req.GetFrameSize(), req.GetForce())
+	if err != nil {
+		log.Errorf("Failed to run self-test of port %s: %s", portName, err)
+		return nil, err
+	}
+
+	return &pb.PortSelfTestResult{
+		Ifname:    portName,
+		Passed:    result.Passed(),
+		Sent:      result.Sent,
+		Received:  result.Received,
+		Corrupted: result.Corrupted,
+		RxErrors:  result.RxErrors,
+		FcsErrors: result.FcsErrors,
+		TxErrors:  result.TxErrors,
+	}, nil
+}
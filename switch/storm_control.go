@@ -0,0 +1,345 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchPort/gRPCServices"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	STORM_CONTROL_REASON        = "storm control"
+	STORM_CONTROL_POLL_INTERVAL = time.Second
+	// Burst allowed above percentage threshold, as part of traffic allowed per second
+	stormBurstDivisor  = 10
+	minStormBurstKbits = 64
+)
+
+// StormRateUnit tells how storm control thresholds are expressed.
+type StormRateUnit int
+
+const (
+	STORM_UNIT_PPS StormRateUnit = iota
+	// Percent of current port speed
+	STORM_UNIT_PERCENT
+)
+
+// StormControlAction tells what happens when traffic exceeds threshold. Excess traffic is
+// always dropped.
+type StormControlAction int
+
+const (
+	STORM_ACTION_DROP StormControlAction = iota
+	STORM_ACTION_ERR_DISABLE
+)
+
+// StormControlConfig represents storm control thresholds of port. Zero threshold does not
+// limit given traffic.
+type StormControlConfig struct {
+	Unit            StormRateUnit      `json:"unit"`
+	Broadcast       uint32             `json:"broadcast,omitempty"`
+	Multicast       uint32             `json:"multicast,omitempty"`
+	UnknownUnicast  uint32             `json:"unknownUnicast,omitempty"`
+	Action          StormControlAction `json:"action"`
+	AutoRecoverySec uint32             `json:"autoRecoverySec,omitempty"`
+}
+
+func (cfg StormControlConfig) enabled() bool {
+	return cfg.Broadcast != 0 || cfg.Multicast != 0 || cfg.UnknownUnicast != 0
+}
+
+func (cfg StormControlConfig) validate() error {
+	switch cfg.Unit {
+	case STORM_UNIT_PPS:
+	case STORM_UNIT_PERCENT:
+		for _, threshold := range []uint32{cfg.Broadcast, cfg.Multicast, cfg.UnknownUnicast} {
+			if threshold > 100 {
+				return fmt.Errorf("Storm control threshold %d%% is above 100%%", threshold)
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown unit %d of storm control thresholds", cfg.Unit)
+	}
+
+	if cfg.Action != STORM_ACTION_DROP && cfg.Action != STORM_ACTION_ERR_DISABLE {
+		return fmt.Errorf("Unknown storm control action %d", cfg.Action)
+	}
+
+	return nil
+}
+
+// stormControl applies storm control thresholds and watches counters of dropped storm
+// traffic, to error-disable ports configured so.
+type stormControl struct {
+	sw         *Switch
+	mtx        sync.Mutex
+	dropped    map[string]uint64
+	violations map[string]uint64
+}
+
+func newStormControl(sw *Switch) *stormControl {
+	return &stormControl{
+		sw:         sw,
+		dropped:    make(map[string]uint64),
+		violations: make(map[string]uint64),
+	}
+}
+
+func (storm *stormControl) config(portName string) StormControlConfig {
+	var cfg StormControlConfig
+	storm.sw.cfg.view(func(c *Config) {
+		cfg = c.StormControl[portName]
+	})
+
+	return cfg
+}
+
+// applyPercent limits traffic to percent of current port speed.
+func (storm *stormControl) applyPercent(port opennsl.Port, flags opennsl.RateFlags, percent uint32) error {
+	kbitsSec, kbitsBurst := uint32(0), uint32(0)
+	if percent != 0 {
+		speed, err := opennsl.PortSpeedGet(storm.sw.asic.unit, port)
+		if err != nil {
+			return err
+		}
+
+		kbitsSec = uint32(speed) * 1000 / 100 * percent
+		kbitsBurst = kbitsSec / stormBurstDivisor
+		if kbitsBurst < minStormBurstKbits {
+			kbitsBurst = minStormBurstKbits
+		}
+	}
+
+	return opennsl.RateBandwidthSet(storm.sw.asic.unit, port, flags, kbitsSec, kbitsBurst)
+}
+
+func (storm *stormControl) apply(portName string, cfg StormControlConfig) error {
+	port, err := portByName(portName)
+	if err != nil {
+		return err
+	}
+
+	unit := storm.sw.asic.unit
+	limits := []struct {
+		flags     opennsl.RateFlags
+		threshold uint32
+		setPps    func(int, int, opennsl.RateFlags, opennsl.Port) error
+	}{
+		{opennsl.RATE_BCAST, cfg.Broadcast, opennsl.RateBcastSet},
+		{opennsl.RATE_MCAST, cfg.Multicast, opennsl.RateMcastSet},
+		{opennsl.RATE_DLF, cfg.UnknownUnicast, opennsl.RateDlfSet},
+	}
+
+	for _, limit := range limits {
+		// Limit in the other unit is removed, so only one of them is in effect
+		if cfg.Unit == STORM_UNIT_PERCENT {
+			if err := limit.setPps(unit, 0, opennsl.RATE_NONE, port); err != nil {
+				return sdkCall("RatePpsSet", err)
+			}
+
+			if err := storm.applyPercent(port, limit.flags, limit.threshold); err != nil {
+				return sdkCall("RateBandwidthSet", err)
+			}
+
+			continue
+		}
+
+		if err := opennsl.RateBandwidthSet(unit, port, limit.flags, 0, 0); err != nil {
+			return sdkCall("RateBandwidthSet", err)
+		}
+
+		flags := limit.flags
+		if limit.threshold == 0 {
+			flags = opennsl.RATE_NONE
+		}
+
+		if err := limit.setPps(unit, int(limit.threshold), flags, port); err != nil {
+			return sdkCall("RatePpsSet", err)
+		}
+	}
+
+	return nil
+}
+
+// Set applies storm control thresholds of port and persists them.
+func (storm *stormControl) Set(portName string, cfg StormControlConfig) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	if err := storm.apply(portName, cfg); err != nil {
+		log.Errorf("Failed to apply storm control on port %s: %s", portName, err)
+		return err
+	}
+
+	return storm.sw.cfg.update(func(c *Config) {
+		if cfg.enabled() {
+			c.StormControl[portName] = cfg
+		} else {
+			delete(c.StormControl, portName)
+		}
+	})
+}
+
+func (storm *stormControl) restore() error {
+	configs := make(map[string]StormControlConfig)
+	storm.sw.cfg.view(func(c *Config) {
+		for portName, cfg := range c.StormControl {
+			configs[portName] = cfg
+		}
+	})
+
+	for portName, cfg := range configs {
+		log.Infof("Restoring storm control on port %s", portName)
+		if err := storm.apply(portName, cfg); err != nil {
+			log.Errorf("Failed to restore storm control on port %s: %s", portName, err)
+		}
+	}
+
+	return nil
+}
+
+// reapplyPercent recomputes thresholds given in percent after link comes up, as speed of
+// port may have been negotiated again.
+func (storm *stormControl) reapplyPercent(portName string) {
+	cfg := storm.config(portName)
+	if !cfg.enabled() || cfg.Unit != STORM_UNIT_PERCENT {
+		return
+	}
+
+	if err := storm.apply(portName, cfg); err != nil {
+		log.Errorf("Failed to reapply storm control on port %s: %s", portName, err)
+	}
+}
+
+func (storm *stormControl) droppedPkts(port opennsl.Port) (uint64, error) {
+	return opennsl.StatGet(storm.sw.asic.unit, port, opennsl.SPL_SNMP_BCM_RX_STORM_CONTROL_DROPS)
+}
+
+// poll error-disables ports which dropped storm traffic since the last poll.
+func (storm *stormControl) poll() {
+	configs := make(map[string]StormControlConfig)
+	storm.sw.cfg.view(func(c *Config) {
+		for portName, cfg := range c.StormControl {
+			configs[portName] = cfg
+		}
+	})
+
+	for portName, cfg := range configs {
+		port, err := portByName(portName)
+		if err != nil {
+			continue
+		}
+
+		dropped, err := storm.droppedPkts(port)
+		if err != nil {
+			sdkCall("StatGet", err)
+			log.Errorf("Failed to read storm control drops of port %s: %s", portName, err)
+			continue
+		}
+
+		storm.mtx.Lock()
+		prevDropped, known := storm.dropped[portName]
+		storm.dropped[portName] = dropped
+		exceeded := known && dropped > prevDropped
+		if exceeded {
+			storm.violations[portName]++
+		}
+		storm.mtx.Unlock()
+
+		if !exceeded || cfg.Action != STORM_ACTION_ERR_DISABLE || storm.sw.IsErrDisabled(portName) {
+			continue
+		}
+
+		log.Warnf("Storm control dropped %d packets on port %s", dropped-prevDropped, portName)
+		autoRecovery := time.Duration(cfg.AutoRecoverySec) * time.Second
+		if err := storm.sw.ErrDisablePort(portName, STORM_CONTROL_REASON, autoRecovery); err != nil {
+			log.Errorf("Failed to error-disable port %s on storm: %s", portName, err)
+		}
+	}
+}
+
+func (storm *stormControl) run(linkEvents chan interface{}) {
+	ticker := time.NewTicker(STORM_CONTROL_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			storm.poll()
+		case ev := <-linkEvents:
+			if event := ev.(*LinkEvent); event.Up {
+				storm.reapplyPercent(event.PortName)
+			}
+		}
+	}
+}
+
+func (storm *stormControl) start() {
+	go storm.run(storm.sw.linkEvents.subscribe())
+}
+
+func (portMgmt *portRequestMgmt) SetStormControl(ctx context.Context, req *pb.PortStormControl) (*pb.PortResult, error) {
+	portName := req.GetIfname()
+	log.Infof("SetStormControl: Ifname %s, unit %s, broadcast %d, multicast %d, unknown unicast %d, action %s",
+		portName, req.GetUnit(), req.GetBroadcast(), req.GetMulticast(), req.GetUnknownUnicast(), req.GetAction())
+	cfg := StormControlConfig{
+		Unit:            StormRateUnit(req.GetUnit()),
+		Broadcast:       req.GetBroadcast(),
+		Multicast:       req.GetMulticast(),
+		UnknownUnicast:  req.GetUnknownUnicast(),
+		Action:          StormControlAction(req.GetAction()),
+		AutoRecoverySec: req.GetAutoRecoverySec(),
+	}
+
+	if err := portMgmt.sw.stormControl.Set(portName, cfg); err != nil {
+		log.Errorf("Failed to set storm control on port %s: %s", portName, err)
+		return &pb.PortResult{Result: pb.PortResult_FAILED}, err
+	}
+
+	return &pb.PortResult{Result: pb.PortResult_SUCCESS}, nil
+}
+
+func (portMgmt *portRequestMgmt) GetStormControl(ctx context.Context, req *pb.PortIface) (*pb.PortStormControlList, error) {
+	storm := portMgmt.sw.stormControl
+	result := &pb.PortStormControlList{}
+	for _, portName := range requestedPorts(req.GetIfname()) {
+		port, err := portByName(portName)
+		if err != nil {
+			log.Errorf("%s", err)
+			return nil, err
+		}
+
+		dropped, err := storm.droppedPkts(port)
+		if err != nil {
+			log.Errorf("Failed to read storm control drops of port %s: %s", portName, err)
+			return nil, err
+		}
+
+		cfg := storm.config(portName)
+		storm.mtx.Lock()
+		violations := storm.violations[portName]
+		storm.mtx.Unlock()
+
+		result.Statuses = append(result.Statuses, &pb.PortStormControlStatus{
+			Config: &pb.PortStormControl{
+				Ifname:          portName,
+				Unit:            pb.PortStormControl_Unit(cfg.Unit),
+				Broadcast:       cfg.Broadcast,
+				Multicast:       cfg.Multicast,
+				UnknownUnicast:  cfg.UnknownUnicast,
+				Action:          pb.PortStormControl_Action(cfg.Action),
+				AutoRecoverySec: cfg.AutoRecoverySec,
+			},
+			DroppedPkts: dropped,
+			Violations:  violations,
+			ErrDisabled: portMgmt.sw.IsErrDisabled(portName),
+		})
+	}
+
+	return result, nil
+}
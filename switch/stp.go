@@ -6,13 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"strings"
+	"time"
 
 	pb "OpenNosPluginForMstpd/gRPCServices"
 
 	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
-	"google.golang.org/grpc"
 )
 
 const (
@@ -25,131 +24,350 @@ type stpRequestMgmt struct {
 	sw *Switch
 }
 
+func toStgStpState(st pb.StpState_State) (opennsl.StgStp, error) {
+	switch st {
+	case pb.StpState_DISABLED:
+		return opennsl.STG_STP_DISABLE, nil
+	case pb.StpState_BLOCKING:
+		return opennsl.STG_STP_BLOCK, nil
+	case pb.StpState_LISTENING:
+		return opennsl.STG_STP_LISTEN, nil
+	case pb.StpState_LEARNING:
+		return opennsl.STG_STP_LEARN, nil
+	case pb.StpState_FORWARDING:
+		return opennsl.STG_STP_FORWARD, nil
+	}
+
+	return opennsl.STG_STP_DISABLE, fmt.Errorf("Invalid STG STP state %d", st)
+}
+
+// stgOfInstance returns STG which serves given spanning tree instance.
+// Only CIST (instance 0) is mapped for now and it uses the default STG.
+func (stpMgmt *stpRequestMgmt) stgOfInstance(instance uint32) (opennsl.Stg, error) {
+	if instance != 0 {
+		return opennsl.Stg(0), fmt.Errorf("Spanning tree instance %d is not mapped to any STG", instance)
+	}
+
+	stg, err := opennsl.StpDefaultGet(stpMgmt.sw.asic.unit)
+	if err != nil {
+		return opennsl.Stg(0), fmt.Errorf("Failed to get default STG STP: %s", err)
+	}
+
+	return stg, nil
+}
+
+// stpPortState describes single STG STP state change of physical port.
+type stpPortState struct {
+	portName string
+	port     opennsl.Port
+	stg      opennsl.Stg
+	state    opennsl.StgStp
+}
+
+// resolveStpState validates requested STP state and translates it to the list
+// of changes on physical ports.
+func (stpMgmt *stpRequestMgmt) resolveStpState(state *pb.StpState) ([]stpPortState, error) {
+	ifname := state.GetInterface().GetIfname()
+	stgStpState, err := toStgStpState(state.GetState())
+	if err != nil {
+		return nil, fmt.Errorf("%s for interface %s", err, ifname)
+	}
+
+	stg, err := stpMgmt.stgOfInstance(state.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+
+	iface, err := stpMgmt.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("There are %d ports behind %s %s", len(iface.Members), iface.Kind, ifname)
+	changes := make([]stpPortState, 0, len(iface.Members))
+	for _, portName := range iface.Members {
+		port, err := portByName(portName)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, stpPortState{
+			portName: portName,
+			port:     port,
+			stg:      stg,
+			state:    stgStpState,
+		})
+	}
+
+	return changes, nil
+}
+
+// applyStpPortState sets STP state of port and MAC learning following it. All sources of STP
+// state go through it and it has to be called with STP lock held.
+func (sw *Switch) applyStpPortState(change stpPortState) error {
+	log.Printf("Setting STP state on port %s", change.portName)
+	if err := sdkCall("StgStpSet", change.stg.StpSet(sw.asic.unit, change.port, change.state)); err != nil {
+		log.Errorf("Failed to set STG STP state %d on port %s (%d)", change.state, change.portName, change.port)
+		return fmt.Errorf("Failed to set STG STP state %d on port %s: %s", change.state, change.portName, err)
+	}
+
+	if err := sw.applyLearning(change.portName, change.port, change.state); err != nil {
+		return fmt.Errorf("Failed to set MAC learning on port %s: %s", change.portName, err)
+	}
+
+	sw.macFlapDetector.stpChanged(change.portName)
+	return nil
+}
+
+// applyStpPortState applies STP state requested over gRPC. From then on the port follows gRPC
+// requests only and state of kernel bridge port is not mirrored to it.
+func (stpMgmt *stpRequestMgmt) applyStpPortState(change stpPortState) error {
+	stpMgmt.sw.stpRpcPorts[change.portName] = struct{}{}
+	return stpMgmt.sw.applyStpPortState(change)
+}
+
 func (stpMgmt *stpRequestMgmt) SetInterfaceState(ctx context.Context, state *pb.StpState) (*pb.StpResult, error) {
 	ifname := state.GetInterface().GetIfname()
 	log.Infof("SetInterfaceState: Ifname %s, state %d", ifname, state.GetState())
-	var portNames []string
-	var portIdx uint16
-	var exists bool
-	if strings.Contains(ifname, "team") {
-		log.Printf("Requested set STP state on LAG %s", ifname)
-		var lag *LAG
-		if lag, exists = stpMgmt.sw.lagIfaces[ifname]; !exists {
-			errMsg := fmt.Sprintf("LAG %s does not exist", ifname)
-			log.Errorf(errMsg)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf(errMsg)
+	stpMgmt.sw.stpMtx.Lock()
+	defer stpMgmt.sw.stpMtx.Unlock()
+
+	changes, err := stpMgmt.resolveStpState(state)
+	if err != nil {
+		log.Errorf("%s", err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, err
+	}
+
+	for _, change := range changes {
+		if err := stpMgmt.applyStpPortState(change); err != nil {
+			return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New(fmt.Sprintf("Failed to set STG STP state %s on port %s",
+				pb.StpState_State_name[int32(state.GetState())], change.portName))
 		}
+	}
 
-		log.Printf("LAG %s has %d members", ifname, len(lag.members))
-		portNames = make([]string, len(lag.members))
-		i := 0
-		for portName := range lag.members {
-			log.Printf("Adding port %s from LAG %s", portName, ifname)
-			portNames[i] = portName
-			i++
+	return &pb.StpResult{Result: pb.StpResult_SUCCESS}, nil
+}
+
+// SetInterfaceStates validates all requested STP states first and then applies them in one pass.
+// When rollback is requested, any failure restores states of already changed ports
+// and nothing is applied if any of the requested states is invalid.
+func (stpMgmt *stpRequestMgmt) SetInterfaceStates(ctx context.Context, batch *pb.StpStateBatch) (*pb.StpBatchResult, error) {
+	states := batch.GetStates()
+	rollback := batch.GetRollbackOnFailure()
+	log.Infof("SetInterfaceStates: %d states, rollback on failure %t", len(states), rollback)
+	stpMgmt.sw.stpMtx.Lock()
+	defer stpMgmt.sw.stpMtx.Unlock()
+
+	results := make([]*pb.StpStateResult, len(states))
+	changes := make([][]stpPortState, len(states))
+	failed := false
+	for i, state := range states {
+		results[i] = &pb.StpStateResult{State: state, Result: pb.StpResult_SUCCESS}
+		var err error
+		if changes[i], err = stpMgmt.resolveStpState(state); err != nil {
+			log.Errorf("Invalid STP state request for interface %s: %s", state.GetInterface().GetIfname(), err)
+			results[i].Result = pb.StpResult_FAILED
+			results[i].Error = err.Error()
+			failed = true
 		}
-	} else {
-		portNames = []string{ifname}
 	}
 
-	log.Printf("There are %d port names", len(portNames))
-	for _, portName := range portNames {
-		log.Printf("Setting STP state on port %s", portName)
-		if portIdx, exists = NamePortIdxMap[portName]; !exists {
-			errMsg := fmt.Sprintf("Port %s does not exist", portName)
-			log.Errorf(errMsg)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf(errMsg)
+	if failed && rollback {
+		return &pb.StpBatchResult{Result: pb.StpResult_FAILED, Results: results,
+			Error: "Some of requested STP states are invalid, nothing has been applied"}, nil
+	}
+
+	var applied []stpPortState
+	for i := range states {
+		if results[i].Result == pb.StpResult_FAILED {
+			continue
+		}
+
+		for _, change := range changes[i] {
+			prevState, err := change.stg.StpGet(stpMgmt.sw.asic.unit, change.port)
+			if err == nil {
+				err = stpMgmt.applyStpPortState(change)
+			}
+
+			if err != nil {
+				results[i].Result = pb.StpResult_FAILED
+				results[i].Error = err.Error()
+				failed = true
+				break
+			}
+
+			change.state = prevState
+			applied = append(applied, change)
 		}
 
-		port := PortNames[portIdx].Port
-
-		var stgStpState opennsl.StgStp
-		switch st := state.GetState(); st {
-		case pb.StpState_DISABLED:
-			stgStpState = opennsl.STG_STP_DISABLE
-		case pb.StpState_BLOCKING:
-			stgStpState = opennsl.STG_STP_BLOCK
-		case pb.StpState_LISTENING:
-			stgStpState = opennsl.STG_STP_LISTEN
-		case pb.StpState_LEARNING:
-			stgStpState = opennsl.STG_STP_LEARN
-		case pb.StpState_FORWARDING:
-			stgStpState = opennsl.STG_STP_FORWARD
-		default:
-			log.Warnf("STG STP state %d not recognized", st)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New(fmt.Sprintf("Invalid STG STP state %s for port %s", pb.StpState_State_name[int32(st)], portName))
+		if failed && rollback {
+			break
 		}
+	}
 
-		var stg opennsl.Stg
-		var err error
-		if stg, err = opennsl.StpDefaultGet(DEFAULT_ASIC_UNIT); err != nil {
-			log.Errorf("Failed to get default STG STP")
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New("Failed to get default STG STP")
+	if !failed {
+		return &pb.StpBatchResult{Result: pb.StpResult_SUCCESS, Results: results}, nil
+	}
+
+	if !rollback {
+		return &pb.StpBatchResult{Result: pb.StpResult_FAILED, Results: results}, nil
+	}
+
+	log.Warnf("Rolling back STP state on %d ports", len(applied))
+	for i := len(applied) - 1; i >= 0; i-- {
+		if err := stpMgmt.applyStpPortState(applied[i]); err != nil {
+			log.Errorf("Failed to roll back STP states: %s", err)
+			return &pb.StpBatchResult{Result: pb.StpResult_FAILED, Results: results,
+				Error: fmt.Sprintf("Failed to roll back STP states: %s", err)}, nil
 		}
+	}
 
-		if err = stg.StpSet(stpMgmt.sw.asic.unit, port, stgStpState); err != nil {
-			log.Errorf("Failed to set STG STP state %d on port %s (%d)", stgStpState, portName, port)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New(fmt.Sprintf("Failed to set STG STP state %s on port %s",
-				pb.StpState_State_name[int32(state.GetState())], portName))
+	for _, result := range results {
+		if result.Result == pb.StpResult_SUCCESS {
+			result.Result = pb.StpResult_FAILED
+			result.Error = "Rolled back"
+		}
+	}
+
+	return &pb.StpBatchResult{Result: pb.StpResult_FAILED, Results: results, RolledBack: true}, nil
+}
+
+var learningModes = map[pb.StpLearningPolicy_Mode]LearningMode{
+	pb.StpLearningPolicy_FOLLOW_STP:      LEARNING_FOLLOW_STP,
+	pb.StpLearningPolicy_ALWAYS_ENABLED:  LEARNING_ALWAYS_ENABLED,
+	pb.StpLearningPolicy_ALWAYS_DISABLED: LEARNING_ALWAYS_DISABLED,
+}
+
+// SetLearningPolicy overrides how STP state of port or LAG members controls hardware MAC learning.
+func (stpMgmt *stpRequestMgmt) SetLearningPolicy(ctx context.Context, req *pb.StpLearningPolicy) (*pb.StpResult, error) {
+	ifname := req.GetInterface().GetIfname()
+	log.Infof("SetLearningPolicy: Ifname %s, mode %s, flush on block %t", ifname, req.GetMode(), req.GetFlushOnBlock())
+	mode, exists := learningModes[req.GetMode()]
+	if !exists {
+		errMsg := fmt.Sprintf("Invalid learning mode %d", req.GetMode())
+		log.Errorf(errMsg)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf(errMsg)
+	}
+
+	l2Iface, err := stpMgmt.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		log.Errorf("%s", err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, err
+	}
+
+	stpMgmt.sw.stpMtx.Lock()
+	defer stpMgmt.sw.stpMtx.Unlock()
+
+	policy := LearningPolicy{Mode: mode, FlushOnBlock: req.GetFlushOnBlock()}
+	for _, portName := range l2Iface.Members {
+		if err := stpMgmt.sw.setLearningPolicy(portName, policy); err != nil {
+			log.Errorf("Failed to set learning policy on port %s: %s", portName, err)
+			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf("Failed to set learning policy on port %s: %s", portName, err)
 		}
 	}
 
 	return &pb.StpResult{Result: pb.StpResult_SUCCESS}, nil
 }
 
+func (stpMgmt *stpRequestMgmt) GetLearningPolicy(ctx context.Context, iface *pb.StpInterface) (*pb.StpLearningPolicy, error) {
+	ifname := iface.GetIfname()
+	l2Iface, err := stpMgmt.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		log.Errorf("%s", err)
+		return nil, err
+	}
+
+	if len(l2Iface.Members) == 0 {
+		return &pb.StpLearningPolicy{Interface: iface}, nil
+	}
+
+	// Policy is set on all LAG members at once, so the first member represents the whole LAG
+	policy := stpMgmt.sw.learningPolicy(l2Iface.Members[0])
+	for pbMode, mode := range learningModes {
+		if mode == policy.Mode {
+			return &pb.StpLearningPolicy{Interface: iface, Mode: pbMode, FlushOnBlock: policy.FlushOnBlock}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unknown learning mode %d of interface %s", policy.Mode, ifname)
+}
+
 // SayHello implements helloworld.GreeterServer
 func (stpMgmt *stpRequestMgmt) FlushFdb(ctx context.Context, iface *pb.StpInterface) (*pb.StpResult, error) {
 	ifname := iface.GetIfname()
-	log.Infof("FlushFdb on ifname %s",ifname )
-	var portNames []string
-	var portIdx uint16
-	var exists bool
-	if strings.Contains(ifname, "team") {
-		log.Printf("Requested set STP state on LAG %s", ifname)
-		var lag *LAG
-		if lag, exists = stpMgmt.sw.lagIfaces[ifname]; !exists {
-			errMsg := fmt.Sprintf("LAG %s does not exist", ifname)
-			log.Errorf(errMsg)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf(errMsg)
-		}
+	log.Infof("FlushFdb on ifname %s", ifname)
+	l2Iface, err := stpMgmt.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		log.Errorf("%s", err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, err
+	}
 
-		log.Printf("LAG %s has %d members", ifname, len(lag.members))
-		portNames = make([]string, len(lag.members))
-		i := 0
-		for portName := range lag.members {
-			log.Printf("Adding port %s from LAG %s", portName, ifname)
-			portNames[i] = portName
-			i++
+	// Deleted addresses are reported by callbacks, so kernel FDB and port security follow the flush
+	flags := opennsl.NewL2DeleteFlags(opennsl.L2_DELETE_PENDING)
+	if l2Iface.Kind == IFACE_KIND_LAG {
+		// Addresses learned on LAG are bound to the trunk, not to its member ports
+		log.Printf("Flushing FDB on LAG %s (trunk %d)", ifname, l2Iface.Trunk)
+		if err := opennsl.L2AddrDeleteByTrunk(DEFAULT_ASIC_UNIT, l2Iface.Trunk, flags); err != nil {
+			log.Errorf("Failed to flush FDB on LAG %s (trunk %d)", ifname, l2Iface.Trunk)
+			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf("Failed to flush FDB on LAG %s: %s", ifname, err)
 		}
-	} else {
-		portNames = []string{ifname}
 	}
 
-	for _, portName := range portNames {
+	for _, portName := range l2Iface.Members {
 		log.Printf("Flushing FDB on port %s", portName)
-		if portIdx, exists = NamePortIdxMap[portName]; !exists {
-			errMsg := fmt.Sprintf("Port %s does not exist", portName)
-			log.Errorf(errMsg)
-			return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf(errMsg)
+		port, err := portByName(portName)
+		if err != nil {
+			log.Errorf("%s", err)
+			return &pb.StpResult{Result: pb.StpResult_FAILED}, err
 		}
 
-		port := PortNames[portIdx].Port
-
-		err := opennsl.L2AddrDeleteByPort(DEFAULT_ASIC_UNIT, opennsl.Module(-1), port, opennsl.NewL2DeleteFlags(opennsl.L2_DELETE_PENDING, opennsl.L2_DELETE_NO_CALLBACKS))
+		err = opennsl.L2AddrDeleteByPort(DEFAULT_ASIC_UNIT, opennsl.Module(-1), port, flags)
 		if err != nil {
 			log.Errorf("Failed to flush FDB on interface %s (%d)", ifname, port)
 			return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New(fmt.Sprintf("Failed to flush FDB on interface %s (%d)", ifname, port))
 		}
 	}
 
+	if err := stpMgmt.sw.fdb.Reinstall(ifname); err != nil {
+		log.Errorf("Failed to reinstall static FDB entries on interface %s: %s", ifname, err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, err
+	}
+
+	stpMgmt.sw.portSecurity.flushed(l2Iface.Members)
 	return &pb.StpResult{Result: pb.StpResult_SUCCESS}, nil
 }
 
 func (stpMgmt *stpRequestMgmt) SetAgeingTime(ctx context.Context, age *pb.StpAgeingTime) (*pb.StpResult, error) {
-	log.Infof("SetAgeingTime for %u", age.AgeingTime)
-	if err := opennsl.L2AddrAgeTimerSet(DEFAULT_ASIC_UNIT, int(age.AgeingTime)); err != nil {
-		log.Errorf("Failed to set ageing of L2 address (%u)", age.AgeingTime)
-		return &pb.StpResult{Result: pb.StpResult_FAILED}, errors.New(fmt.Sprintf("Failed to set ageing of L2 address (%u)", age.AgeingTime))
+	log.Infof("SetAgeingTime for %d", age.GetAgeingTime())
+	if err := stpMgmt.sw.SetAgeingTime(age.GetAgeingTime()); err != nil {
+		log.Errorf("Failed to set ageing of L2 address (%d): %s", age.GetAgeingTime(), err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf("Failed to set ageing of L2 address (%d): %s", age.GetAgeingTime(), err)
+	}
+
+	return &pb.StpResult{Result: pb.StpResult_SUCCESS}, nil
+}
+
+func (stpMgmt *stpRequestMgmt) GetAgeingTime(ctx context.Context, req *pb.StpEmpty) (*pb.StpAgeingState, error) {
+	state, err := stpMgmt.sw.AgeingState()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get ageing of L2 address: %s", err)
+	}
+
+	return &pb.StpAgeingState{
+		AgeingTime:             state.AgeingTime,
+		ActiveAgeingTime:       state.ActiveAgeingTime,
+		FastAgeing:             state.FastAgeing,
+		FastAgeingRemainingSec: uint32(state.FastAgeingLeft / time.Second),
+	}, nil
+}
+
+// StartFastAgeing shortens ageing time for the period of STP topology change.
+func (stpMgmt *stpRequestMgmt) StartFastAgeing(ctx context.Context, req *pb.StpFastAgeing) (*pb.StpResult, error) {
+	log.Infof("StartFastAgeing with ageing time %d for %d s", req.GetAgeingTime(), req.GetDurationSec())
+	duration := time.Duration(req.GetDurationSec()) * time.Second
+	if err := stpMgmt.sw.StartFastAgeing(req.GetAgeingTime(), duration); err != nil {
+		log.Errorf("Failed to start fast ageing: %s", err)
+		return &pb.StpResult{Result: pb.StpResult_FAILED}, fmt.Errorf("Failed to start fast ageing: %s", err)
 	}
 
 	return &pb.StpResult{Result: pb.StpResult_SUCCESS}, nil
@@ -160,7 +378,7 @@ func HandleSTPRequest(sw *Switch) {
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
+	s := newGrpcServer()
 	pb.RegisterStpManagementServer(s, &stpRequestMgmt{sw: sw})
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
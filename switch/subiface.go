@@ -0,0 +1,201 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchVlan/gRPCServices"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+// SubIfaceConfig represents VLAN sub-interface of physical port.
+type SubIfaceConfig struct {
+	Ifname string       `json:"ifname"`
+	Vlan   uint16       `json:"vlan"`
+	Mode   SubIfaceMode `json:"mode"`
+}
+
+func (sw *Switch) l2Port(portName string) (*L2Port, error) {
+	sw.ifaceMtx.RLock()
+	defer sw.ifaceMtx.RUnlock()
+
+	l2Port, exists := sw.l2Ports[portName]
+	if !exists {
+		return nil, fmt.Errorf("L2 port %s does not exist", portName)
+	}
+
+	return l2Port, nil
+}
+
+// applyTagStripping sets tag stripping of port netdev or of management interface.
+func (sw *Switch) applyTagStripping(ifname string, stripTag bool) error {
+	sw.ifaceMtx.RLock()
+	mgmtIface, exists := sw.mgmtIfaces[ifname]
+	sw.ifaceMtx.RUnlock()
+	if exists {
+		return mgmtIface.SetStripTag(stripTag)
+	}
+
+	l2Port, err := sw.l2Port(ifname)
+	if err != nil {
+		return err
+	}
+
+	return l2Port.SetStripTag(stripTag)
+}
+
+// SetTagStripping tells if VLAN tag is stripped from frames delivered to port netdev or to
+// management interface.
+func (sw *Switch) SetTagStripping(portName string, stripTag bool) error {
+	if err := sw.applyTagStripping(portName, stripTag); err != nil {
+		return err
+	}
+
+	return sw.cfg.update(func(c *Config) {
+		if stripTag {
+			delete(c.KeepVlanTag, portName)
+		} else {
+			c.KeepVlanTag[portName] = true
+		}
+	})
+}
+
+// AddSubIface creates VLAN sub-interface of physical port.
+func (sw *Switch) AddSubIface(portName string, vlan opennsl.Vlan, mode SubIfaceMode) error {
+	if err := validateVlan(vlan); err != nil {
+		return err
+	}
+
+	if _, exists := subIfaceModeNames[mode]; !exists {
+		return fmt.Errorf("Unknown mode %d of sub-interface", mode)
+	}
+
+	l2Port, err := sw.l2Port(portName)
+	if err != nil {
+		return err
+	}
+
+	if err := l2Port.AddSubIface(vlan, mode); err != nil {
+		return err
+	}
+
+	log.Infof("Created sub-interface %s (%s)", subIfaceName(portName, vlan), mode)
+	if mode == SUBIF_MODE_KNET_NETDEV {
+		sw.links.setCarrier(subIfaceName(portName, vlan), sw.links.linkUp(portName))
+	}
+
+	return sw.cfg.update(func(c *Config) {
+		c.SubIfaces[subIfaceName(portName, vlan)] = SubIfaceConfig{Ifname: portName, Vlan: uint16(vlan), Mode: mode}
+	})
+}
+
+// DeleteSubIface removes VLAN sub-interface of physical port.
+func (sw *Switch) DeleteSubIface(portName string, vlan opennsl.Vlan) error {
+	l2Port, err := sw.l2Port(portName)
+	if err != nil {
+		return err
+	}
+
+	if err := l2Port.DeleteSubIface(vlan); err != nil {
+		return err
+	}
+
+	return sw.cfg.update(func(c *Config) {
+		delete(c.SubIfaces, subIfaceName(portName, vlan))
+	})
+}
+
+func (sw *Switch) restoreSubIfaces() error {
+	keepTag := make([]string, 0)
+	subIfaces := make([]SubIfaceConfig, 0)
+	sw.cfg.view(func(c *Config) {
+		for portName := range c.KeepVlanTag {
+			keepTag = append(keepTag, portName)
+		}
+
+		for _, subIface := range c.SubIfaces {
+			subIfaces = append(subIfaces, subIface)
+		}
+	})
+
+	for _, portName := range keepTag {
+		if err := sw.applyTagStripping(portName, false); err != nil {
+			log.Errorf("Failed to restore tag stripping of %s: %s", portName, err)
+		}
+	}
+
+	for _, subIface := range subIfaces {
+		l2Port, err := sw.l2Port(subIface.Ifname)
+		if err == nil {
+			err = l2Port.AddSubIface(opennsl.Vlan(subIface.Vlan), subIface.Mode)
+		}
+
+		if err != nil {
+			log.Errorf("Failed to restore sub-interface of %s in VLAN %d: %s", subIface.Ifname, subIface.Vlan, err)
+		}
+	}
+
+	return nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) SetTagStripping(ctx context.Context, req *pb.VlanTagStripping) (*pb.VlanResult, error) {
+	log.Infof("SetTagStripping: Ifname %s, strip %t", req.GetIfname(), req.GetStrip())
+	if err := vlanMgmt.sw.SetTagStripping(req.GetIfname(), req.GetStrip()); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) AddSubIface(ctx context.Context, req *pb.VlanSubIface) (*pb.VlanResult, error) {
+	log.Infof("AddSubIface: Ifname %s, VLAN %d, mode %s", req.GetIfname(), req.GetVid(), req.GetMode())
+	if err := vlanMgmt.sw.AddSubIface(req.GetIfname(), opennsl.Vlan(req.GetVid()), SubIfaceMode(req.GetMode())); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) DeleteSubIface(ctx context.Context, req *pb.VlanSubIface) (*pb.VlanResult, error) {
+	log.Infof("DeleteSubIface: Ifname %s, VLAN %d", req.GetIfname(), req.GetVid())
+	if err := vlanMgmt.sw.DeleteSubIface(req.GetIfname(), opennsl.Vlan(req.GetVid())); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) ListSubIfaces(ctx context.Context, req *pb.VlanEmpty) (*pb.VlanSubIfaceList, error) {
+	result := &pb.VlanSubIfaceList{}
+	vlanMgmt.sw.cfg.view(func(c *Config) {
+		for _, subIface := range c.SubIfaces {
+			result.SubIfaces = append(result.SubIfaces, &pb.VlanSubIface{
+				Ifname: subIface.Ifname,
+				Vid:    uint32(subIface.Vlan),
+				Mode:   pb.VlanSubIface_Mode(subIface.Mode),
+			})
+		}
+
+		for portName := range c.KeepVlanTag {
+			result.TagStripping = append(result.TagStripping, &pb.VlanTagStripping{Ifname: portName, Strip: false})
+		}
+	})
+
+	sort.Slice(result.SubIfaces, func(i, j int) bool {
+		if result.SubIfaces[i].Ifname != result.SubIfaces[j].Ifname {
+			return result.SubIfaces[i].Ifname < result.SubIfaces[j].Ifname
+		}
+
+		return result.SubIfaces[i].Vid < result.SubIfaces[j].Vid
+	})
+	sort.Slice(result.TagStripping, func(i, j int) bool {
+		return result.TagStripping[i].Ifname < result.TagStripping[j].Ifname
+	})
+
+	return result, nil
+}
@@ -1,6 +1,10 @@
 package bcm
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/beluganos/go-opennsl/opennsl"
 	log "github.com/sirupsen/logrus"
 )
@@ -10,24 +14,117 @@ type Asic struct {
 	unit int
 }
 
+// l2Ageing keeps ageing time of L2 addresses and state of fast ageing.
+type l2Ageing struct {
+	mtx             sync.Mutex
+	ageingTime      uint32
+	configured      bool
+	fastAgeingTime  uint32
+	fastAgeingUntil time.Time
+	fastAgeingTimer *time.Timer
+	// Range of age timer accepted by the chip, found on first use
+	minAgeingTime uint32
+	maxAgeingTime uint32
+	limitsKnown   bool
+}
+
 // Switch represents configured parameters in Broadcom network switch layer.
 type Switch struct {
-	asic      Asic
-	stg       opennsl.Stg
-	lagIfaces map[string]*LAG
+	asic             Asic
+	stg              opennsl.Stg
+	lagIfaces        map[string]*LAG
+	l2Ports          map[string]*L2Port
+	ifaceMtx         sync.RWMutex
+	vlanIfaces       map[string]opennsl.Vlan
+	mgmtIfaces       map[string]*MgmtIface
+	stpMtx           sync.Mutex
+	stpRpcPorts      map[string]struct{}
+	cfg              *configStore
+	errDisableMtx    sync.Mutex
+	errDisabled      map[string]*errDisabledPort
+	errDisableGen    uint64
+	errDisableEvents *eventHub
+	bpduProtection   *bpduProtection
+	ageing           l2Ageing
+	fdb              *fdbManager
+	l2AddrWatcher    *l2AddrWatcher
+	l2Events         *eventHub
+	portSecurity     *portSecurity
+	macFlapDetector  *macFlapDetector
+	macFlapEvents    *eventHub
+	vlans            *vlanManager
+	isolation        *portIsolation
+	ports            *portManager
+	links            *linkMonitor
+	linkEvents       *eventHub
+	stats            *portStats
+	stormControl     *stormControl
+	mirror           *mirrorManager
+	selfTest         *selfTest
 }
 
 func NewSwitch() *Switch {
-	return &Switch{
-		asic:      Asic{unit: DEFAULT_ASIC_UNIT},
-		stg:       opennsl.Stg(1),
-		lagIfaces: make(map[string]*LAG),
+	sw := &Switch{
+		asic:             Asic{unit: DEFAULT_ASIC_UNIT},
+		stg:              opennsl.Stg(1),
+		lagIfaces:        make(map[string]*LAG),
+		l2Ports:          make(map[string]*L2Port),
+		vlanIfaces:       make(map[string]opennsl.Vlan),
+		mgmtIfaces:       make(map[string]*MgmtIface),
+		stpRpcPorts:      make(map[string]struct{}),
+		cfg:              newConfigStore(DEFAULT_CONFIG_FILE),
+		errDisabled:      make(map[string]*errDisabledPort),
+		errDisableEvents: newEventHub("error-disable"),
+		l2Events:         newEventHub("L2 address"),
+		macFlapEvents:    newEventHub("MAC flap"),
+		linkEvents:       newEventHub("link"),
+	}
+
+	sw.bpduProtection = newBpduProtection(sw)
+	sw.fdb = newFdbManager(sw)
+	sw.l2AddrWatcher = newL2AddrWatcher(sw)
+	sw.portSecurity = newPortSecurity(sw)
+	sw.macFlapDetector = newMacFlapDetector(sw)
+	sw.vlans = newVlanManager(sw)
+	sw.isolation = newPortIsolation(sw)
+	sw.ports = newPortManager(sw)
+	sw.links = newLinkMonitor(sw)
+	sw.stats = newPortStats(sw)
+	sw.stormControl = newStormControl(sw)
+	sw.mirror = newMirrorManager(sw)
+	sw.selfTest = newSelfTest(sw)
+	return sw
+}
+
+// AddL2Port makes created L2 port manageable by the switch.
+func (sw *Switch) AddL2Port(l2Port *L2Port) {
+	sw.l2Ports[l2Port.portName] = l2Port
+}
+
+// RegisterRxHandlers registers callbacks for packets which KNET passes to the daemon.
+func (sw *Switch) RegisterRxHandlers(rx *Rx) error {
+	if err := rx.RegisterHandler(RX_NAME_SELF_TEST, RX_PRIO_SELF_TEST, sw.selfTest.handleRxPacket); err != nil {
+		log.Errorf("Failed to register Rx handler for port self-test: %s", err)
+		return err
+	}
+
+	if err := rx.RegisterHandler(RX_NAME_BPDU_PROTECTION, RX_PRIO_BPDU_PROTECTION, sw.bpduProtection.handleRxPacket); err != nil {
+		log.Errorf("Failed to register Rx handler for BPDU protection: %s", err)
+		return err
 	}
+
+	if err := rx.RegisterHandler(RX_NAME_PORT_SECURITY, RX_PRIO_PORT_SECURITY, sw.portSecurity.handleRxPacket); err != nil {
+		log.Errorf("Failed to register Rx handler for port security: %s", err)
+		return err
+	}
+
+	return nil
 }
 
 const (
 	DEFAULT_ASIC_UNIT = 0
 	NUM_OF_PORTS      = 32
+	CPU_PORT          = opennsl.Port(0)
 	PORT_1            = "eth-1"
 	PORT_2            = "eth-2"
 	PORT_3            = "eth-3"
@@ -137,6 +234,27 @@ var NamePortIdxMap = map[string]uint16{
 	PORT_32: 31,
 }
 
+// portByName returns BCM port of front panel port name.
+func portByName(portName string) (opennsl.Port, error) {
+	portIdx, exists := NamePortIdxMap[portName]
+	if !exists {
+		return opennsl.Port(0), fmt.Errorf("Port %s does not exist", portName)
+	}
+
+	return PortNames[portIdx].Port, nil
+}
+
+// portNameOf returns front panel port name of BCM port.
+func portNameOf(port opennsl.Port) (string, error) {
+	for _, portNameMap := range PortNames {
+		if portNameMap.Port == port {
+			return portNameMap.PortName, nil
+		}
+	}
+
+	return "", fmt.Errorf("BCM port %d is not a front panel port", port)
+}
+
 // var PortNameMap [NUM_OF_PORTS]Port_NameMap
 
 // func init() {
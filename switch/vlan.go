@@ -0,0 +1,630 @@
+package bcm
+
+import (
+	pb "OpenNosSwitchVlan/gRPCServices"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/beluganos/go-opennsl/opennsl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	vlanMgmtPort = ":50056"
+)
+
+// VlanMember represents tagging mode of port or LAG which is member of VLAN.
+type VlanMember struct {
+	Untagged bool `json:"untagged"`
+}
+
+// VlanConfig represents VLAN created by user together with its members.
+type VlanConfig struct {
+	Members map[string]VlanMember `json:"members"`
+}
+
+// vlanManager keeps VLANs and their membership in sync with the ASIC. Membership of
+// LAG is applied to all ports aggregated by the LAG.
+type vlanManager struct {
+	sw  *Switch
+	mtx sync.Mutex
+	// VLANs created on behalf of kernel bridge, which are not kept in config
+	kernelVlans map[opennsl.Vlan]struct{}
+}
+
+func newVlanManager(sw *Switch) *vlanManager {
+	return &vlanManager{
+		sw:          sw,
+		kernelVlans: make(map[opennsl.Vlan]struct{}),
+	}
+}
+
+func validateVlan(vid opennsl.Vlan) error {
+	if !vid.Valid() {
+		return fmt.Errorf("VLAN ID %d is not valid", vid)
+	}
+
+	return nil
+}
+
+func (vlans *vlanManager) exists(vid opennsl.Vlan) bool {
+	if vid == opennsl.VLAN_ID_DEFAULT {
+		return true
+	}
+
+	exists := false
+	vlans.sw.cfg.view(func(c *Config) {
+		_, exists = c.Vlans[vid]
+	})
+
+	return exists
+}
+
+func (vlans *vlanManager) addPorts(vid opennsl.Vlan, ports []opennsl.Port, untagged bool) error {
+	pbmp := opennsl.NewPBmp()
+	ubmp := opennsl.NewPBmp()
+	for _, port := range ports {
+		pbmp.Add(port)
+		if untagged {
+			ubmp.Add(port)
+		}
+	}
+
+	return vid.PortAdd(vlans.sw.asic.unit, pbmp, ubmp)
+}
+
+func (vlans *vlanManager) removePorts(vid opennsl.Vlan, ports []opennsl.Port) error {
+	pbmp := opennsl.NewPBmp()
+	for _, port := range ports {
+		pbmp.Add(port)
+	}
+
+	return vid.PortRemove(vlans.sw.asic.unit, pbmp)
+}
+
+func (vlans *vlanManager) setPortPvid(ports []opennsl.Port, vid opennsl.Vlan) error {
+	for _, port := range ports {
+		if err := opennsl.PortUntaggedVlanSet(vlans.sw.asic.unit, port, vid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func memberPorts(iface *LogicalIface) ([]opennsl.Port, error) {
+	ports := make([]opennsl.Port, 0, len(iface.Members))
+	for _, portName := range iface.Members {
+		port, err := portByName(portName)
+		if err != nil {
+			return nil, err
+		}
+
+		ports = append(ports, port)
+	}
+
+	return ports, nil
+}
+
+// CreateVlan creates VLAN without any members.
+func (vlans *vlanManager) CreateVlan(vid opennsl.Vlan) error {
+	if err := validateVlan(vid); err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if vlans.exists(vid) {
+		return nil
+	}
+
+	// VLAN created for kernel bridge is taken over and kept from now on
+	if _, exists := vlans.kernelVlans[vid]; exists {
+		delete(vlans.kernelVlans, vid)
+	} else if _, err := vid.Create(vlans.sw.asic.unit); err != nil {
+		return fmt.Errorf("Failed to create VLAN %d: %s", vid, err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		c.Vlans[vid] = VlanConfig{Members: make(map[string]VlanMember)}
+	})
+}
+
+// DeleteVlan removes all members from VLAN and destroys it. VLAN which is used as PVID
+// of any interface cannot be deleted.
+func (vlans *vlanManager) DeleteVlan(vid opennsl.Vlan) error {
+	if vid == opennsl.VLAN_ID_DEFAULT {
+		return fmt.Errorf("Default VLAN cannot be deleted")
+	}
+
+	// Members are resolved before VLAN manager is locked, as LAG changes take locks in
+	// reverse order
+	var members []string
+	vlans.sw.cfg.view(func(c *Config) {
+		for ifname := range c.Vlans[vid].Members {
+			members = append(members, ifname)
+		}
+	})
+
+	ports := make(map[string][]opennsl.Port)
+	for _, ifname := range members {
+		iface, err := vlans.sw.ResolveL2Iface(ifname)
+		if err != nil {
+			log.Warnf("Skipping removal of %s from VLAN %d: %s", ifname, vid, err)
+			continue
+		}
+
+		ifacePorts, err := memberPorts(iface)
+		if err != nil {
+			return err
+		}
+
+		ports[ifname] = ifacePorts
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	var vlanCfg VlanConfig
+	exists := false
+	pvidOf := ""
+	vlans.sw.cfg.view(func(c *Config) {
+		vlanCfg, exists = c.Vlans[vid]
+		for ifname, pvid := range c.Pvid {
+			if pvid == vid {
+				pvidOf = ifname
+			}
+		}
+	})
+
+	if !exists {
+		return fmt.Errorf("VLAN %d does not exist", vid)
+	}
+
+	if len(pvidOf) > 0 {
+		return fmt.Errorf("VLAN %d is PVID of %s", vid, pvidOf)
+	}
+
+	for ifname := range vlanCfg.Members {
+		ifacePorts, resolved := ports[ifname]
+		if !resolved {
+			continue
+		}
+
+		if err := vlans.removePorts(vid, ifacePorts); err != nil {
+			return fmt.Errorf("Failed to remove %s from VLAN %d: %s", ifname, vid, err)
+		}
+	}
+
+	if err := vid.Destroy(vlans.sw.asic.unit); err != nil {
+		return fmt.Errorf("Failed to destroy VLAN %d: %s", vid, err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		delete(c.Vlans, vid)
+	})
+}
+
+// AddMember adds port or LAG to VLAN. Tagging mode of existing member is updated.
+func (vlans *vlanManager) AddMember(vid opennsl.Vlan, ifname string, untagged bool) error {
+	iface, err := vlans.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if vid != opennsl.VLAN_ID_DEFAULT && !vlans.exists(vid) {
+		return fmt.Errorf("VLAN %d does not exist", vid)
+	}
+
+	if err := vlans.addPorts(vid, ports, untagged); err != nil {
+		return fmt.Errorf("Failed to add %s to VLAN %d: %s", ifname, vid, err)
+	}
+
+	if vid == opennsl.VLAN_ID_DEFAULT {
+		return nil
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		c.Vlans[vid].Members[ifname] = VlanMember{Untagged: untagged}
+	})
+}
+
+// RemoveMember removes port or LAG from VLAN.
+func (vlans *vlanManager) RemoveMember(vid opennsl.Vlan, ifname string) error {
+	iface, err := vlans.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if !vlans.exists(vid) {
+		return fmt.Errorf("VLAN %d does not exist", vid)
+	}
+
+	if err := vlans.removePorts(vid, ports); err != nil {
+		return fmt.Errorf("Failed to remove %s from VLAN %d: %s", ifname, vid, err)
+	}
+
+	if vid == opennsl.VLAN_ID_DEFAULT {
+		return nil
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		delete(c.Vlans[vid].Members, ifname)
+	})
+}
+
+// SetPvid sets VLAN assigned to untagged frames received on port or LAG.
+func (vlans *vlanManager) SetPvid(ifname string, vid opennsl.Vlan) error {
+	iface, err := vlans.sw.ResolveL2Iface(ifname)
+	if err != nil {
+		return err
+	}
+
+	ports, err := memberPorts(iface)
+	if err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if !vlans.exists(vid) {
+		return fmt.Errorf("VLAN %d does not exist", vid)
+	}
+
+	if err := vlans.setPortPvid(ports, vid); err != nil {
+		return fmt.Errorf("Failed to set PVID %d of %s: %s", vid, ifname, err)
+	}
+
+	return vlans.sw.cfg.update(func(c *Config) {
+		if vid == opennsl.VLAN_ID_DEFAULT {
+			delete(c.Pvid, ifname)
+		} else {
+			c.Pvid[ifname] = vid
+		}
+	})
+}
+
+// applyIface programs VLAN membership and PVID configured for interface on given ports.
+func (vlans *vlanManager) applyIface(ifname string, ports []opennsl.Port) error {
+	memberships := make(map[opennsl.Vlan]VlanMember)
+	pvid := opennsl.VLAN_ID_NONE
+	vlans.sw.cfg.view(func(c *Config) {
+		for vid, vlanCfg := range c.Vlans {
+			if member, exists := vlanCfg.Members[ifname]; exists {
+				memberships[vid] = member
+			}
+		}
+
+		if vid, exists := c.Pvid[ifname]; exists {
+			pvid = vid
+		}
+	})
+
+	for vid, member := range memberships {
+		if err := vlans.addPorts(vid, ports, member.Untagged); err != nil {
+			return fmt.Errorf("Failed to add %s to VLAN %d: %s", ifname, vid, err)
+		}
+	}
+
+	if pvid != opennsl.VLAN_ID_NONE {
+		if err := vlans.setPortPvid(ports, pvid); err != nil {
+			return fmt.Errorf("Failed to set PVID %d of %s: %s", pvid, ifname, err)
+		}
+	}
+
+	return vlans.applyQinq(ifname, ports)
+}
+
+// releaseIface removes VLAN membership, PVID and Q-in-Q settings configured for interface from
+// given ports, which no longer belong to it.
+func (vlans *vlanManager) releaseIface(ifname string, ports []opennsl.Port) error {
+	memberOf := make([]opennsl.Vlan, 0)
+	pvidSet := false
+	vlans.sw.cfg.view(func(c *Config) {
+		for vid, vlanCfg := range c.Vlans {
+			if _, exists := vlanCfg.Members[ifname]; exists {
+				memberOf = append(memberOf, vid)
+			}
+		}
+
+		_, pvidSet = c.Pvid[ifname]
+	})
+
+	for _, vid := range memberOf {
+		if err := vlans.removePorts(vid, ports); err != nil {
+			return fmt.Errorf("Failed to remove %s from VLAN %d: %s", ifname, vid, err)
+		}
+	}
+
+	if pvidSet {
+		if err := vlans.setPortPvid(ports, opennsl.VLAN_ID_DEFAULT); err != nil {
+			return fmt.Errorf("Failed to reset PVID of %s: %s", ifname, err)
+		}
+	}
+
+	return vlans.releaseQinq(ifname, ports)
+}
+
+// addLagMember applies VLAN settings of LAG to port which has just joined it. It must be called
+// with interface registry unlocked, as interface resolution runs under VLAN manager lock.
+func (vlans *vlanManager) addLagMember(lagIfname string, port opennsl.Port) error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	return vlans.applyIface(lagIfname, []opennsl.Port{port})
+}
+
+// removeLagMember removes VLAN settings of LAG from ports which have left it. It must be called
+// with interface registry unlocked.
+func (vlans *vlanManager) removeLagMember(lagIfname string, ports []opennsl.Port) error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	return vlans.releaseIface(lagIfname, ports)
+}
+
+// configuredMember tells if interface is member of VLAN configured through VLAN service.
+// Such membership takes precedence over the kernel bridge.
+func (vlans *vlanManager) configuredMember(vid opennsl.Vlan, ifname string) bool {
+	configured := false
+	vlans.sw.cfg.view(func(c *Config) {
+		_, configured = c.Vlans[vid].Members[ifname]
+	})
+
+	return configured
+}
+
+// addKernelMember adds ports of interface to VLAN configured on kernel bridge. VLAN is created if
+// needed. Membership configured through VLAN service is left as it is.
+func (vlans *vlanManager) addKernelMember(vid opennsl.Vlan, ifname string, ports []opennsl.Port, untagged bool) error {
+	if err := validateVlan(vid); err != nil {
+		return err
+	}
+
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if _, exists := vlans.kernelVlans[vid]; !exists && !vlans.exists(vid) {
+		if _, err := vid.Create(vlans.sw.asic.unit); err != nil {
+			return fmt.Errorf("Failed to create VLAN %d: %s", vid, err)
+		}
+
+		vlans.kernelVlans[vid] = struct{}{}
+	}
+
+	if vlans.configuredMember(vid, ifname) {
+		log.Debugf("%s is configured member of VLAN %d, ignoring its bridge membership", ifname, vid)
+		return nil
+	}
+
+	return vlans.addPorts(vid, ports, untagged)
+}
+
+// removeKernelMember removes ports of interface from VLAN, unless the membership is configured
+// through VLAN service.
+func (vlans *vlanManager) removeKernelMember(vid opennsl.Vlan, ifname string, ports []opennsl.Port) error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if vlans.configuredMember(vid, ifname) {
+		return nil
+	}
+
+	return vlans.removePorts(vid, ports)
+}
+
+// setKernelPvid sets PVID of interface from kernel bridge, unless PVID is configured through
+// VLAN service.
+func (vlans *vlanManager) setKernelPvid(ifname string, ports []opennsl.Port, vid opennsl.Vlan) error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	configured := false
+	vlans.sw.cfg.view(func(c *Config) {
+		_, configured = c.Pvid[ifname]
+	})
+
+	if configured {
+		log.Debugf("PVID of %s is configured, ignoring PVID %d of bridge", ifname, vid)
+		return nil
+	}
+
+	return vlans.setPortPvid(ports, vid)
+}
+
+// releaseKernelVlan destroys VLAN created for kernel bridge, which is not used by the bridge any
+// more. VLANs configured through VLAN service are kept.
+func (vlans *vlanManager) releaseKernelVlan(vid opennsl.Vlan) error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	if _, exists := vlans.kernelVlans[vid]; !exists {
+		return nil
+	}
+
+	delete(vlans.kernelVlans, vid)
+	if vlans.exists(vid) {
+		return nil
+	}
+
+	return vid.Destroy(vlans.sw.asic.unit)
+}
+
+// restore creates VLANs saved in config and applies membership of physical ports. Membership
+// of LAGs is applied when ports join them.
+func (vlans *vlanManager) restore() error {
+	vlans.mtx.Lock()
+	defer vlans.mtx.Unlock()
+
+	ifnames := make(map[string]struct{})
+	vids := make([]opennsl.Vlan, 0)
+	vlans.sw.cfg.view(func(c *Config) {
+		for vid, vlanCfg := range c.Vlans {
+			vids = append(vids, vid)
+			for ifname := range vlanCfg.Members {
+				ifnames[ifname] = struct{}{}
+			}
+		}
+
+		for ifname := range c.Pvid {
+			ifnames[ifname] = struct{}{}
+		}
+
+		for ifname := range c.QinqPorts {
+			ifnames[ifname] = struct{}{}
+		}
+
+		for _, entry := range c.VlanTranslations {
+			ifnames[entry.Ifname] = struct{}{}
+		}
+	})
+
+	for _, vid := range vids {
+		if _, err := vid.Create(vlans.sw.asic.unit); err != nil {
+			log.Errorf("Failed to create VLAN %d: %s", vid, err)
+		}
+	}
+
+	for ifname := range ifnames {
+		port, err := portByName(ifname)
+		if err != nil {
+			continue
+		}
+
+		if err := vlans.applyIface(ifname, []opennsl.Port{port}); err != nil {
+			log.Errorf("Failed to restore VLAN settings of %s: %s", ifname, err)
+		}
+	}
+
+	return nil
+}
+
+// List returns VLANs created by user with their members, and PVIDs other than default VLAN.
+func (vlans *vlanManager) List() ([]*pb.VlanInfo, []*pb.VlanPvid) {
+	vlanInfos := make([]*pb.VlanInfo, 0)
+	pvids := make([]*pb.VlanPvid, 0)
+	vlans.sw.cfg.view(func(c *Config) {
+		for vid, vlanCfg := range c.Vlans {
+			vlanInfo := &pb.VlanInfo{Vid: uint32(vid)}
+			for ifname, member := range vlanCfg.Members {
+				vlanInfo.Members = append(vlanInfo.Members, &pb.VlanMember{
+					Vid:      uint32(vid),
+					Ifname:   ifname,
+					Untagged: member.Untagged,
+				})
+			}
+
+			sort.Slice(vlanInfo.Members, func(i, j int) bool {
+				return vlanInfo.Members[i].Ifname < vlanInfo.Members[j].Ifname
+			})
+			vlanInfos = append(vlanInfos, vlanInfo)
+		}
+
+		for ifname, vid := range c.Pvid {
+			pvids = append(pvids, &pb.VlanPvid{Ifname: ifname, Vid: uint32(vid)})
+		}
+	})
+
+	sort.Slice(vlanInfos, func(i, j int) bool {
+		return vlanInfos[i].Vid < vlanInfos[j].Vid
+	})
+	sort.Slice(pvids, func(i, j int) bool {
+		return pvids[i].Ifname < pvids[j].Ifname
+	})
+
+	return vlanInfos, pvids
+}
+
+type vlanRequestMgmt struct {
+	pb.UnimplementedVlanManagementServer
+	sw *Switch
+}
+
+func (vlanMgmt *vlanRequestMgmt) CreateVlan(ctx context.Context, req *pb.VlanId) (*pb.VlanResult, error) {
+	log.Infof("CreateVlan: VLAN %d", req.GetVid())
+	if err := vlanMgmt.sw.vlans.CreateVlan(opennsl.Vlan(req.GetVid())); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) DeleteVlan(ctx context.Context, req *pb.VlanId) (*pb.VlanResult, error) {
+	log.Infof("DeleteVlan: VLAN %d", req.GetVid())
+	if err := vlanMgmt.sw.vlans.DeleteVlan(opennsl.Vlan(req.GetVid())); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) AddVlanMember(ctx context.Context, req *pb.VlanMember) (*pb.VlanResult, error) {
+	log.Infof("AddVlanMember: VLAN %d, Ifname %s, untagged %t", req.GetVid(), req.GetIfname(), req.GetUntagged())
+	if err := vlanMgmt.sw.vlans.AddMember(opennsl.Vlan(req.GetVid()), req.GetIfname(), req.GetUntagged()); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) RemoveVlanMember(ctx context.Context, req *pb.VlanMember) (*pb.VlanResult, error) {
+	log.Infof("RemoveVlanMember: VLAN %d, Ifname %s", req.GetVid(), req.GetIfname())
+	if err := vlanMgmt.sw.vlans.RemoveMember(opennsl.Vlan(req.GetVid()), req.GetIfname()); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) SetPvid(ctx context.Context, req *pb.VlanPvid) (*pb.VlanResult, error) {
+	log.Infof("SetPvid: Ifname %s, VLAN %d", req.GetIfname(), req.GetVid())
+	if err := vlanMgmt.sw.vlans.SetPvid(req.GetIfname(), opennsl.Vlan(req.GetVid())); err != nil {
+		log.Errorf("%s", err)
+		return &pb.VlanResult{Result: pb.VlanResult_FAILED}, err
+	}
+
+	return &pb.VlanResult{Result: pb.VlanResult_SUCCESS}, nil
+}
+
+func (vlanMgmt *vlanRequestMgmt) ListVlans(ctx context.Context, req *pb.VlanEmpty) (*pb.VlanList, error) {
+	vlanInfos, pvids := vlanMgmt.sw.vlans.List()
+	qinqPorts, translations := vlanMgmt.sw.vlans.listQinq()
+	return &pb.VlanList{Vlans: vlanInfos, Pvids: pvids, QinqPorts: qinqPorts, Translations: translations}, nil
+}
+
+func HandleVlanRequest(sw *Switch) {
+	lis, err := net.Listen("tcp", vlanMgmtPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := newGrpcServer()
+	pb.RegisterVlanManagementServer(s, &vlanRequestMgmt{sw: sw})
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}